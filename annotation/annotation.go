@@ -0,0 +1,112 @@
+// Package annotation parses glue directives from RPC method doc comments.
+//
+// Directives are line comments of the form `//glue:key`, `//glue:key=value`
+// or `//glue:key value`, e.g.
+//
+//	//glue:skip
+//	//glue:name=SumAll
+//	//glue:deprecated use Sum2
+//	//glue:timeout=2s
+//	//glue:idempotent
+package annotation
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"time"
+)
+
+const prefix = "//glue:"
+
+// Annotations describes the directives attached to an RPC method.
+type Annotations struct {
+	// Skip excludes the method from generated code.
+	Skip bool
+	// Name overrides the name of the generated client method. The RPC method
+	// name sent over the wire is unchanged.
+	Name string
+	// Deprecated marks the generated client method as deprecated.
+	Deprecated bool
+	// DeprecationNote is an optional note explaining the deprecation
+	// (e.g. `use Sum2`).
+	DeprecationNote string
+	// Timeout bounds each call of the method. Zero means no timeout.
+	Timeout time.Duration
+	// Idempotent marks the method as safe to retry.
+	Idempotent bool
+}
+
+// An Error describes a malformed directive.
+type Error struct {
+	// Pos is the position of the directive's comment.
+	Pos token.Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// Parse extracts Annotations from a doc comment. A nil doc comment yields
+// zero Annotations. Malformed directives are reported through the error (an
+// *Error), but do not prevent the remaining directives from being parsed.
+func Parse(doc *ast.CommentGroup) (Annotations, error) {
+	var a Annotations
+	if doc == nil {
+		return a, nil
+	}
+
+	var err error
+	fail := func(c *ast.Comment, format string, args ...interface{}) {
+		if err == nil {
+			err = &Error{Pos: c.Slash, Msg: fmt.Sprintf(format, args...)}
+		}
+	}
+
+	for _, c := range doc.List {
+		if !strings.HasPrefix(c.Text, prefix) {
+			continue
+		}
+
+		key, value := split(strings.TrimPrefix(c.Text, prefix))
+		switch key {
+		case "skip":
+			a.Skip = true
+		case "name":
+			if !token.IsIdentifier(value) || !token.IsExported(value) {
+				fail(c, "glue:name must be an exported identifier, found %q", value)
+				continue
+			}
+			a.Name = value
+		case "deprecated":
+			a.Deprecated = true
+			a.DeprecationNote = value
+		case "timeout":
+			timeout, perr := time.ParseDuration(value)
+			if perr != nil || timeout <= 0 {
+				fail(c, "glue:timeout must be a positive duration, found %q", value)
+				continue
+			}
+			a.Timeout = timeout
+		case "idempotent":
+			a.Idempotent = true
+		default:
+			fail(c, "unknown directive glue:%s", key)
+		}
+	}
+
+	return a, err
+}
+
+// split separates a directive into its key and value. The value follows
+// either `=` or whitespace.
+func split(directive string) (key, value string) {
+	i := strings.IndexAny(directive, "= \t")
+	if i == -1 {
+		return strings.TrimSpace(directive), ""
+	}
+
+	return directive[:i], strings.TrimSpace(directive[i+1:])
+}
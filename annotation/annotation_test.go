@@ -0,0 +1,98 @@
+package annotation_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+	"time"
+
+	"github.com/segmentio/glue/annotation"
+)
+
+// doc builds a doc comment of lines, the nth at position n+1.
+func doc(lines ...string) *ast.CommentGroup {
+	g := &ast.CommentGroup{}
+	for i, line := range lines {
+		g.List = append(g.List, &ast.Comment{Slash: token.Pos(i + 1), Text: line})
+	}
+	return g
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		lines []string
+		want  annotation.Annotations
+	}{
+		{nil, annotation.Annotations{}},
+		{[]string{"// Sum adds numbers.", "// glue:skip is not a directive."}, annotation.Annotations{}},
+		{[]string{"//glue:skip"}, annotation.Annotations{Skip: true}},
+		{[]string{"//glue:name=SumAll"}, annotation.Annotations{Name: "SumAll"}},
+		{[]string{"//glue:name SumAll"}, annotation.Annotations{Name: "SumAll"}},
+		{[]string{"//glue:deprecated"}, annotation.Annotations{Deprecated: true}},
+		{[]string{"//glue:deprecated use Sum2"}, annotation.Annotations{Deprecated: true, DeprecationNote: "use Sum2"}},
+		{[]string{"//glue:deprecated=use Sum2 "}, annotation.Annotations{Deprecated: true, DeprecationNote: "use Sum2"}},
+		{[]string{"//glue:timeout=2s"}, annotation.Annotations{Timeout: 2 * time.Second}},
+		{[]string{"//glue:timeout\t1m30s"}, annotation.Annotations{Timeout: 90 * time.Second}},
+		{[]string{"//glue:idempotent"}, annotation.Annotations{Idempotent: true}},
+		{
+			[]string{"// Sum adds numbers.", "//", "//glue:name=Add", "//glue:timeout=500ms", "//glue:idempotent"},
+			annotation.Annotations{Name: "Add", Timeout: 500 * time.Millisecond, Idempotent: true},
+		},
+	}
+	for _, test := range tests {
+		var g *ast.CommentGroup
+		if test.lines != nil {
+			g = doc(test.lines...)
+		}
+
+		got, err := annotation.Parse(g)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.lines, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", test.lines, got, test.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		lines []string
+		err   string
+		pos   token.Pos
+		// want are the directives parsed despite the error.
+		want annotation.Annotations
+	}{
+		{[]string{"//glue:name"}, `glue:name must be an exported identifier, found ""`, 1, annotation.Annotations{}},
+		{[]string{"//glue:name=sumAll"}, `glue:name must be an exported identifier, found "sumAll"`, 1, annotation.Annotations{}},
+		{[]string{"//glue:name=Sum-All"}, `glue:name must be an exported identifier, found "Sum-All"`, 1, annotation.Annotations{}},
+		{[]string{"//glue:timeout"}, `glue:timeout must be a positive duration, found ""`, 1, annotation.Annotations{}},
+		{[]string{"//glue:timeout=soon"}, `glue:timeout must be a positive duration, found "soon"`, 1, annotation.Annotations{}},
+		{[]string{"//glue:timeout=-1s"}, `glue:timeout must be a positive duration, found "-1s"`, 1, annotation.Annotations{}},
+		{[]string{"//glue:timeout=0"}, `glue:timeout must be a positive duration, found "0"`, 1, annotation.Annotations{}},
+		{[]string{"//glue:skipp"}, "unknown directive glue:skipp", 1, annotation.Annotations{}},
+		{
+			// The first malformed directive is reported, and valid ones are
+			// still parsed.
+			[]string{"//glue:skip", "//glue:timeout=soon", "//glue:retry", "//glue:idempotent"},
+			`glue:timeout must be a positive duration, found "soon"`,
+			2,
+			annotation.Annotations{Skip: true, Idempotent: true},
+		},
+	}
+	for _, test := range tests {
+		got, err := annotation.Parse(doc(test.lines...))
+		e, ok := err.(*annotation.Error)
+		if !ok {
+			t.Errorf("Parse(%q): err = %v, want an *annotation.Error", test.lines, err)
+			continue
+		}
+		if e.Msg != test.err || e.Pos != test.pos {
+			t.Errorf("Parse(%q): err = %q at %d, want %q at %d", test.lines, e.Msg, e.Pos, test.err, test.pos)
+		}
+		if got != test.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", test.lines, got, test.want)
+		}
+	}
+}
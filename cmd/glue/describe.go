@@ -0,0 +1,24 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/segmentio/glue"
+)
+
+// describe prints the description of the service as JSON.
+func describe(walker glue.Walker, directions glue.Directions) int {
+	svc, err := walker.Describe(directions)
+	if err != nil {
+		return 2
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(svc); err != nil {
+		return 1
+	}
+
+	return 0
+}
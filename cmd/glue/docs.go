@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"log/slog"
+
+	"github.com/segmentio/glue"
+)
+
+// defaultDocsOut is the output directory of reference pages unless -out is
+// set.
+const defaultDocsOut = "./docs"
+
+// docs generates reference pages of the service: Markdown by default, or HTML
+// with -format html.
+func docs(walker glue.Walker, directions glue.Directions, logger *slog.Logger) int {
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	if !set["format"] {
+		directions.Format = glue.FormatMarkdown
+	}
+	switch directions.Format {
+	case glue.FormatMarkdown, glue.FormatHTML:
+	default:
+		logger.Error("docs supports the markdown and html formats", slog.String("format", directions.Format))
+		return 2
+	}
+
+	if !set["out"] {
+		*out = defaultDocsOut
+	}
+
+	return generate(walker, directions, logger)
+}
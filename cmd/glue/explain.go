@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/segmentio/glue"
+)
+
+// explain prints why each method of the declaration was included or skipped.
+func explain(walker glue.Walker, directions glue.Directions) int {
+	explanations, err := walker.Explain(directions)
+	if err != nil {
+		return 2
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(explanations); err != nil {
+			return 1
+		}
+		return 0
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "POSITION\tMETHOD\tVERDICT\tRULE\tREASON")
+	for _, e := range explanations {
+		fmt.Fprintf(tw, "%s:%d\t%s\t%s\t%s\t%s\n",
+			e.File, e.Line, e.Method, e.Verdict, dash(e.Rule), dash(e.Reason))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
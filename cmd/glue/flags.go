@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/segmentio/glue"
+	"github.com/segmentio/glue/filter"
+)
+
+// patterns is a repeatable flag of comma-separated method patterns.
+type patterns []string
+
+func (p *patterns) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *patterns) Set(v string) error {
+	for _, pattern := range strings.Split(v, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			// Report invalid globs and regexps along with the flag.
+			if _, err := filter.New([]string{strings.TrimPrefix(pattern, "!")}, nil); err != nil {
+				return err
+			}
+			*p = append(*p, pattern)
+		}
+	}
+	return nil
+}
+
+// clientList is a repeatable flag of clients to generate, each formatted as
+// `Name:pattern,!pattern`. Patterns prefixed with `!` are excluded.
+type clientList []glue.ClientDirections
+
+func (c *clientList) String() string {
+	var names []string
+	for _, client := range *c {
+		names = append(names, client.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (c *clientList) Set(v string) error {
+	parts := strings.SplitN(v, ":", 2)
+	name := strings.TrimSpace(parts[0])
+	if name == "" {
+		return errors.New("expected Name:pattern,!pattern")
+	}
+	if !token.IsIdentifier(name) {
+		return fmt.Errorf("invalid client name %q", name)
+	}
+
+	for _, client := range *c {
+		if client.Name == name {
+			return fmt.Errorf("duplicate client %s", name)
+		}
+	}
+
+	client := glue.ClientDirections{Name: name}
+	var all patterns
+	if len(parts) == 2 {
+		if err := all.Set(parts[1]); err != nil {
+			return err
+		}
+	}
+	for _, pattern := range all {
+		if strings.HasPrefix(pattern, "!") {
+			client.Exclude = append(client.Exclude, pattern[1:])
+		} else {
+			client.Include = append(client.Include, pattern)
+		}
+	}
+
+	*c = append(*c, client)
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/segmentio/glue"
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/lint"
+	"github.com/segmentio/glue/log"
+)
+
+// lintRules lists the names of every lint rule for flag usage.
+func lintRules() string {
+	names := make([]string, 0, len(lint.Rules))
+	for _, r := range lint.Rules {
+		names = append(names, r.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// runLint checks the declaration and prints every diagnostic to stdout, as
+// text unless -diagnostics says otherwise. It fails if there are any.
+func runLint(walker glue.Walker, directions glue.Directions, diags *diagnostic.Collector, logger *slog.Logger) int {
+	lintErr := walker.Lint(directions, lint.Config{
+		Enable:     enableRules,
+		Disable:    disableRules,
+		MaxArgSize: *maxArgSize,
+	})
+
+	format := *diagFormat
+	if format == "" {
+		format = diagnostic.FormatText
+	}
+
+	found := diags.Diagnostics()
+	if err := writeDiagnostics(os.Stdout, format, found); err != nil {
+		logger.Error("failed to write diagnostics", log.Err(err))
+		return 1
+	}
+
+	if lintErr != nil {
+		return 2
+	}
+
+	if len(found) > 0 {
+		return 1
+	}
+
+	return 0
+}
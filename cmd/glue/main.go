@@ -2,9 +2,15 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/segmentio/glue"
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/lint"
 	"github.com/segmentio/glue/log"
 	"github.com/segmentio/glue/provider"
 	"github.com/segmentio/glue/provider/gorilla"
@@ -13,6 +19,9 @@ import (
 )
 
 var debug = flag.Bool("debug", false, "enable debug logs")
+var logFormat = flag.String("log-format", log.FormatText, "log format (text or json)")
+var diagFormat = flag.String("diagnostics", "", "report diagnostics about the server code as text, json or sarif")
+var diagOut = flag.String("diagnostics-out", "", "file to write diagnostics to (default stderr)")
 
 // Required
 var name = flag.String("name", "", "target RPC declaration name (e.g. Service in `type Service struct`)")
@@ -21,62 +30,268 @@ var service = flag.String("service", "", "RPC service name (e.g. `Service` in `S
 // Overrides
 var out = flag.String("out", "./client", "output directory")
 var print = flag.Bool("print", false, "output code to stdout instead of file")
+var manifestPath = flag.String("manifest", "", "write a JSON manifest of generated files to this path in the output directory (e.g. glue.manifest.json), and remove files it lists that are no longer generated")
+var check = flag.Bool("check", false, "verify generated code in the output directory is up to date instead of writing it")
+var pkg = flag.String("package", "client", "output package name")
+var format = flag.String("format", glue.FormatGo, "output format: "+strings.Join(glue.FormatNames(), ", "))
+var mocks = flag.Bool("mocks", false, "also generate a mock of each client for tests (go format)")
+var inProcess = flag.Bool("inprocess", false, "also generate a client.Client calling the server's methods directly, for tests (go format)")
+var stampVersion = flag.Bool("stamp-version", false, "record the glue version in generated files (they then go stale whenever glue is rebuilt)")
+var filename = flag.String("filename", "", "output file name template (fields: .Package, .Service, .Client, .Provider; default depends on -format, e.g. "+glue.DefaultFilename+")")
+
+// Method filters
+var include patterns
+var exclude patterns
+var clients clientList
+
+func init() {
+	flag.Var(&include, "include", "only generate methods matching a glob or /regexp/ (repeatable)")
+	flag.Var(&exclude, "exclude", "skip methods matching a glob or /regexp/ (repeatable)")
+	flag.Var(&clients, "client", "generate a separate client as `Name:pattern,!pattern`, where ! excludes (repeatable, replaces -include and -exclude)")
+}
+
+// Explain
+var jsonFlag = flag.Bool("json", false, "explain: output JSON instead of a table")
+
+// Lint
+var enableRules patterns
+var disableRules patterns
+var maxArgSize = flag.Int64("max-arg-size", lint.DefaultMaxArgSize, "lint: size in bytes above which args passed by value are reported")
+
+func init() {
+	flag.Var(&enableRules, "enable", "lint: only run these rules (repeatable): "+lintRules())
+	flag.Var(&disableRules, "disable", "lint: don't run these rules (repeatable)")
+}
 
 // Custom providers (only pick one)
 var gorillaFlag = flag.Bool("gorilla", false, "supports Gorilla rpc method format")
 
+// Commands other than the default (generating clients).
+const (
+	cmdExplain  = "explain"
+	cmdLint     = "lint"
+	cmdDescribe = "describe"
+	cmdDocs     = "docs"
+)
+
 func main() {
-	flag.Parse()
+	cmd, args := splitCommand(os.Args[1:])
+	flag.CommandLine.Parse(args)
 
+	level := slog.LevelInfo
 	if *debug {
-		log.DebugMode = true
+		level = slog.LevelDebug
 	}
 
-	if *service == "" {
-		log.Print("-service is required")
+	logger, err := log.New(os.Stderr, *logFormat, level)
+	if err != nil {
+		log.Default().Error(err.Error())
 		os.Exit(2)
 	}
 
 	if *name == "" {
-		log.Print("-name is required")
+		logger.Error("-name is required")
 		os.Exit(2)
 	}
 
-	var wr writer.Writer
-	if *print {
-		wr = writer.NewStdoutWriter()
-	} else {
-		var err error
-		wr, err = writer.NewFileWriter(*out)
-		if err != nil {
-			os.Exit(1)
-		}
-	}
-
-	var provider provider.Provider = &stl.Provider{}
+	var provider provider.Provider = &stl.Provider{Logger: logger}
 	if *gorillaFlag {
 		provider = gorilla.New(provider)
 	}
 
+	diags := &diagnostic.Collector{}
 	walker := glue.Walker{
 		Provider: provider,
-		Writer:   wr,
+		Logger:   logger,
+		Reporter: diags,
 	}
 
 	var path string
-	args := flag.Args()
-	if len(args) == 0 {
+	if flag.NArg() == 0 {
 		path = "."
 	} else {
-		path = args[0]
+		path = flag.Arg(0)
+	}
+
+	directions := glue.Directions{
+		Path:         path,
+		Name:         *name,
+		Service:      *service,
+		Include:      include,
+		Exclude:      exclude,
+		Clients:      clients,
+		Package:      *pkg,
+		Format:       *format,
+		Filename:     *filename,
+		Command:      command(),
+		Manifest:     *manifestPath,
+		Mocks:        *mocks,
+		InProcess:    *inProcess,
+		StampVersion: *stampVersion,
 	}
 
-	err := walker.Walk(glue.Directions{
-		Path:    path,
-		Name:    *name,
-		Service: *service,
-	})
+	var code int
+	switch cmd {
+	case cmdExplain:
+		code = explain(walker, directions)
+	case cmdLint:
+		code = runLint(walker, directions, diags, logger)
+	case cmdDescribe:
+		code = describe(walker, directions)
+	case cmdDocs:
+		code = docs(walker, directions, logger)
+	default:
+		code = generate(walker, directions, logger)
+	}
+
+	// Lint prints diagnostics as its output.
+	if *diagFormat != "" && cmd != cmdLint {
+		if err := writeDiagnostics(os.Stderr, *diagFormat, diags.Diagnostics()); err != nil {
+			logger.Error("failed to write diagnostics", log.Err(err))
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+
+	os.Exit(code)
+}
+
+// writeDiagnostics writes diags in format to -diagnostics-out, or to w if
+// it's not set.
+func writeDiagnostics(w io.Writer, format string, diags []diagnostic.Diagnostic) error {
+	tool := diagnostic.Tool{
+		Name:    "glue",
+		Version: glue.Version,
+		URI:     "https://github.com/segmentio/glue",
+	}
+
+	if *diagOut == "" {
+		return diagnostic.Write(w, format, tool, diags)
+	}
+
+	f, err := os.Create(*diagOut)
 	if err != nil {
-		os.Exit(2)
+		return err
+	}
+
+	if err := diagnostic.Write(f, format, tool, diags); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// splitCommand separates an optional leading command (e.g. `explain`) from
+// the flags and arguments that follow it.
+func splitCommand(args []string) (string, []string) {
+	if len(args) > 0 {
+		switch args[0] {
+		case cmdExplain, cmdLint, cmdDescribe, cmdDocs:
+			return args[0], args[1:]
+		}
 	}
+
+	return "", args
+}
+
+func generate(walker glue.Walker, directions glue.Directions, logger *slog.Logger) int {
+	if *service == "" {
+		logger.Error("-service is required")
+		return 2
+	}
+
+	var checker *writer.CheckWriter
+	if *check {
+		checker = writer.NewCheckWriter(*out)
+		walker.Writer = checker
+	} else if *print {
+		walker.Writer = writer.NewStdoutWriter()
+	} else {
+		fw, err := writer.NewFileWriter(*out)
+		if err != nil {
+			return 1
+		}
+		fw.Logger = logger
+		walker.Writer = fw
+	}
+
+	if err := walker.Walk(directions); err != nil {
+		return 2
+	}
+
+	if checker != nil {
+		stale := checker.Stale()
+		for _, path := range stale {
+			fmt.Print(checker.Diff(path))
+		}
+
+		if len(stale) > 0 {
+			logger.Error("generated files are out of date, rerun glue", slog.Int("stale", len(stale)))
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// command reconstructs the command line that invoked glue, quoting arguments
+// as needed so it can be pasted into a shell.
+func command() string {
+	args := []string{"glue"}
+	for i := 1; i < len(os.Args); i++ {
+		arg := os.Args[i]
+
+		// Leave out flags that don't affect the generated code so that
+		// -check reproduces it exactly.
+		if name, hasValue, ok := modeFlag(arg); ok {
+			if !hasValue && !isBoolFlag(name) {
+				i++
+			}
+			continue
+		}
+
+		switch {
+		case strings.ContainsAny(arg, "\r\n"):
+			// Keep the command on one line with bash's $'...' quoting.
+			r := strings.NewReplacer(`\`, `\\`, "'", `\'`, "\r", `\r`, "\n", `\n`)
+			arg = "$'" + r.Replace(arg) + "'"
+		case arg == "" || strings.ContainsAny(arg, " \t'\"`$\\*?[]{}()<>|&;#!~"):
+			arg = "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+		}
+		args = append(args, arg)
+	}
+
+	return strings.Join(args, " ")
+}
+
+// modeFlag reports whether arg is one of the flags that only change how glue
+// runs (e.g. -print), not what it generates. hasValue is set if arg includes
+// the flag's value (e.g. -log-format=json).
+func modeFlag(arg string) (name string, hasValue bool, ok bool) {
+	name = strings.TrimLeft(arg, "-")
+	if name == arg {
+		return "", false, false
+	}
+
+	parts := strings.SplitN(name, "=", 2)
+	name = parts[0]
+
+	switch name {
+	case "check", "print", "debug", "json", "log-format", "diagnostics", "diagnostics-out":
+		return name, len(parts) == 2, true
+	}
+
+	return "", false, false
+}
+
+func isBoolFlag(name string) bool {
+	f := flag.Lookup(name)
+	if f == nil {
+		return false
+	}
+
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
 }
@@ -0,0 +1,62 @@
+package glue
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/filter"
+	"github.com/segmentio/glue/spec"
+)
+
+// Describe walks the source code like Walk, but instead of generating code it
+// returns the description of the service. Include and Exclude of directions
+// select its methods; Clients and output options are ignored.
+func (w *Walker) Describe(directions Directions) (*spec.Service, error) {
+	w = w.rooted(directions)
+
+	if directions.Service == "" {
+		err := errors.New("service is required")
+		w.invalidDirections(err)
+		return nil, err
+	}
+
+	f, err := filter.New(directions.Include, directions.Exclude)
+	if err != nil {
+		w.invalidDirections(err)
+		return nil, err
+	}
+
+	prgm, err := w.load(directions.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret *spec.Service
+	for _, pkg := range prgm.InitialPackages() {
+		svc, _ := w.describePackage(pkg, prgm, directions, f)
+		if svc == nil {
+			continue
+		}
+
+		if ret != nil {
+			err := fmt.Errorf("RPC declaration %s found in both %s and %s", directions.Name, ret.Package, svc.Package)
+			w.invalidDirections(err)
+			return nil, err
+		}
+		ret = svc
+	}
+
+	if ret == nil {
+		w.logger().Error("could not find RPC declaration")
+		w.reporter().Report(diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Rule:     "declaration-not-found",
+			Message:  fmt.Sprintf("could not find RPC declaration %s with suitable methods in %s", directions.Name, directions.Path),
+			File:     localDir(directions.Path),
+		})
+		return nil, errors.New("not found")
+	}
+
+	return ret, nil
+}
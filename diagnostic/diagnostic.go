@@ -0,0 +1,135 @@
+// Package diagnostic describes problems glue finds in server code, with
+// source positions, and renders them as text, JSON lines or SARIF.
+package diagnostic
+
+import (
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Severity ranks a Diagnostic. Values match SARIF result levels.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+// A Diagnostic is a single finding.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	// Rule identifies the kind of finding (e.g. `reply-not-pointer`).
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+	// File, Line and Column locate the finding. File is slash-separated and
+	// relative to the module root when reported through Relative, and
+	// absolute otherwise. Line and Column are 1-based and zero when unknown.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// At returns a Diagnostic located at pos.
+func At(pos token.Position, severity Severity, rule, message string) Diagnostic {
+	return Diagnostic{
+		Severity: severity,
+		Rule:     rule,
+		Message:  message,
+		File:     pos.Filename,
+		Line:     pos.Line,
+		Column:   pos.Column,
+	}
+}
+
+// A Reporter receives diagnostics. Implementations must be safe for
+// concurrent use.
+type Reporter interface {
+	Report(Diagnostic)
+}
+
+// Relative returns a Reporter passing diagnostics on to r with their absolute
+// File paths made relative to root, the module root, so that they don't depend
+// on where the module is checked out. Files outside root are left absolute.
+func Relative(root string, r Reporter) Reporter {
+	return relative{root: root, r: r}
+}
+
+type relative struct {
+	root string
+	r    Reporter
+}
+
+func (rel relative) Report(d Diagnostic) {
+	if filepath.IsAbs(d.File) {
+		if p, err := filepath.Rel(rel.root, d.File); err == nil && p != ".." && !strings.HasPrefix(p, ".."+string(filepath.Separator)) {
+			d.File = filepath.ToSlash(p)
+		}
+	}
+	rel.r.Report(d)
+}
+
+// Discard is a Reporter that drops every diagnostic.
+var Discard Reporter = discard{}
+
+type discard struct{}
+
+func (discard) Report(Diagnostic) {}
+
+// OrDiscard returns r, or Discard if r is nil.
+func OrDiscard(r Reporter) Reporter {
+	if r == nil {
+		return Discard
+	}
+	return r
+}
+
+// Collector is a Reporter that keeps every diagnostic.
+type Collector struct {
+	mu    sync.Mutex
+	diags []Diagnostic
+}
+
+func (c *Collector) Report(d Diagnostic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.diags = append(c.diags, d)
+}
+
+// Diagnostics returns the reported diagnostics sorted by position.
+func (c *Collector) Diagnostics() []Diagnostic {
+	c.mu.Lock()
+	diags := append([]Diagnostic(nil), c.diags...)
+	c.mu.Unlock()
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		a, b := diags[i], diags[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return diags
+}
+
+// HasErrors reports whether any diagnostic has SeverityError.
+func (c *Collector) HasErrors() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, d := range c.diags {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
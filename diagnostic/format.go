@@ -0,0 +1,191 @@
+package diagnostic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Formats supported by Write.
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Tool describes the program that produced diagnostics, for SARIF output.
+type Tool struct {
+	Name    string
+	Version string
+	URI     string
+}
+
+// Write renders diagnostics to w in format.
+func Write(w io.Writer, format string, tool Tool, diags []Diagnostic) error {
+	switch format {
+	case FormatText:
+		return WriteText(w, diags)
+	case FormatJSON:
+		return WriteJSON(w, diags)
+	case FormatSARIF:
+		return WriteSARIF(w, tool, diags)
+	}
+
+	return fmt.Errorf("unknown diagnostics format %q", format)
+}
+
+// WriteText renders diagnostics one per line, compiler style
+// (`file:line:column: severity: message [rule]`).
+func WriteText(w io.Writer, diags []Diagnostic) error {
+	for _, d := range diags {
+		pos := d.File
+		if pos != "" && d.Line > 0 {
+			pos = fmt.Sprintf("%s:%d", pos, d.Line)
+			if d.Column > 0 {
+				pos = fmt.Sprintf("%s:%d", pos, d.Column)
+			}
+		}
+		if pos != "" {
+			pos += ": "
+		}
+
+		if _, err := fmt.Fprintf(w, "%s%s: %s [%s]\n", pos, d.Severity, d.Message, d.Rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON renders diagnostics as JSON lines.
+func WriteJSON(w io.Writer, diags []Diagnostic) error {
+	enc := json.NewEncoder(w)
+	for _, d := range diags {
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteSARIF renders diagnostics as a SARIF 2.1.0 log with a single run.
+func WriteSARIF(w io.Writer, tool Tool, diags []Diagnostic) error {
+	ruleSet := map[string]bool{}
+	results := make([]sarifResult, 0, len(diags))
+	for _, d := range diags {
+		ruleSet[d.Rule] = true
+
+		r := sarifResult{
+			RuleID:  d.Rule,
+			Level:   string(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+		}
+
+		if d.File != "" {
+			// Relative files are resolved against the root of the analyzed
+			// source, which SARIF consumers know as %SRCROOT%.
+			loc := sarifLocation{}
+			if filepath.IsAbs(d.File) {
+				path := filepath.ToSlash(d.File)
+				if !strings.HasPrefix(path, "/") {
+					// Windows paths (e.g. `C:/src`).
+					path = "/" + path
+				}
+				loc.PhysicalLocation.ArtifactLocation.URI = (&url.URL{Scheme: "file", Path: path}).String()
+			} else {
+				loc.PhysicalLocation.ArtifactLocation.URI = (&url.URL{Path: filepath.ToSlash(d.File)}).String()
+				loc.PhysicalLocation.ArtifactLocation.URIBaseID = "%SRCROOT%"
+			}
+			if d.Line > 0 {
+				loc.PhysicalLocation.Region = &sarifRegion{
+					StartLine:   d.Line,
+					StartColumn: d.Column,
+				}
+			}
+			r.Locations = []sarifLocation{loc}
+		}
+
+		results = append(results, r)
+	}
+
+	rules := make([]sarifRule, 0, len(ruleSet))
+	for id := range ruleSet {
+		rules = append(rules, sarifRule{ID: id})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           tool.Name,
+				Version:        tool.Version,
+				InformationURI: tool.URI,
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI       string `json:"uri"`
+			URIBaseID string `json:"uriBaseId,omitempty"`
+		} `json:"artifactLocation"`
+		Region *sarifRegion `json:"region,omitempty"`
+	} `json:"physicalLocation"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
@@ -0,0 +1,82 @@
+package diagnostic_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/glue/diagnostic"
+)
+
+func TestRelative(t *testing.T) {
+	root := filepath.FromSlash("/src/mod")
+
+	tests := []struct {
+		file string
+		want string
+	}{
+		{filepath.FromSlash("/src/mod/math/service.go"), "math/service.go"},
+		{filepath.FromSlash("/src/other/service.go"), filepath.FromSlash("/src/other/service.go")},
+		{filepath.FromSlash("/src/mod..x/service.go"), filepath.FromSlash("/src/mod..x/service.go")},
+		{"math/service.go", "math/service.go"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		var c diagnostic.Collector
+		diagnostic.Relative(root, &c).Report(diagnostic.Diagnostic{File: test.file})
+		if got := c.Diagnostics()[0].File; got != test.want {
+			t.Errorf("Relative(%q) = %q, want %q", test.file, got, test.want)
+		}
+	}
+}
+
+func TestWriteSARIFLocations(t *testing.T) {
+	abs, err := filepath.Abs("service.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := []diagnostic.Diagnostic{
+		{Severity: diagnostic.SeverityError, Rule: "a", File: "math/my service.go", Line: 3},
+		{Severity: diagnostic.SeverityError, Rule: "b", File: abs},
+	}
+
+	var b bytes.Buffer
+	if err := diagnostic.WriteSARIF(&b, diagnostic.Tool{Name: "glue"}, diags); err != nil {
+		t.Fatal(err)
+	}
+
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI       string `json:"uri"`
+							URIBaseID string `json:"uriBaseId"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(b.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+
+	results := log.Runs[0].Results
+	rel := results[0].Locations[0].PhysicalLocation.ArtifactLocation
+	if rel.URI != "math/my%20service.go" || rel.URIBaseID != "%SRCROOT%" {
+		t.Errorf("relative location = %+v", rel)
+	}
+	loc := results[1].Locations[0].PhysicalLocation.ArtifactLocation
+	path := filepath.ToSlash(abs)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if want := "file://" + path; loc.URI != want || loc.URIBaseID != "" {
+		t.Errorf("absolute location = %+v, want %s", loc, want)
+	}
+}
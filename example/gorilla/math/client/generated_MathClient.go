@@ -1,6 +1,13 @@
+// Code generated by glue. DO NOT EDIT.
+//
+// Source: github.com/segmentio/glue/example/gorilla/math
+// Command: glue -gorilla -name Service -service Math
+
 package client
 
 import (
+	"context"
+
 	"github.com/segmentio/glue/client"
 
 	"github.com/segmentio/glue/example/gorilla/math"
@@ -24,6 +31,23 @@ type MathIFace interface {
 	MapOfPrimitives(args map[string]string) ([]int, error)
 }
 
+type MathContextIFace interface {
+	Sum(args math.SumArg) (math.SumReply, error)
+	SumContext(ctx context.Context, args math.SumArg) (math.SumReply, error)
+
+	Identity(args int) (int, error)
+	IdentityContext(ctx context.Context, args int) (int, error)
+
+	IdentityMany(args []int) ([]int, error)
+	IdentityManyContext(ctx context.Context, args []int) ([]int, error)
+
+	IdentityManyStruct(args []*math.IdentityStruct) ([]math.IdentityStruct, error)
+	IdentityManyStructContext(ctx context.Context, args []*math.IdentityStruct) ([]math.IdentityStruct, error)
+
+	MapOfPrimitives(args map[string]string) ([]int, error)
+	MapOfPrimitivesContext(ctx context.Context, args map[string]string) ([]int, error)
+}
+
 type Math struct {
 	RPC client.Client
 }
@@ -34,26 +58,56 @@ func (c *Math) Sum(args math.SumArg) (math.SumReply, error) {
 	return reply, err
 }
 
+func (c *Math) SumContext(ctx context.Context, args math.SumArg) (math.SumReply, error) {
+	var reply math.SumReply
+	err := c.RPC.CallContext(ctx, "Math.Sum", args, &reply)
+	return reply, err
+}
+
 func (c *Math) Identity(args int) (int, error) {
 	var reply int
 	err := c.RPC.Call("Math.Identity", args, &reply)
 	return reply, err
 }
 
+func (c *Math) IdentityContext(ctx context.Context, args int) (int, error) {
+	var reply int
+	err := c.RPC.CallContext(ctx, "Math.Identity", args, &reply)
+	return reply, err
+}
+
 func (c *Math) IdentityMany(args []int) ([]int, error) {
 	var reply []int
 	err := c.RPC.Call("Math.IdentityMany", args, &reply)
 	return reply, err
 }
 
+func (c *Math) IdentityManyContext(ctx context.Context, args []int) ([]int, error) {
+	var reply []int
+	err := c.RPC.CallContext(ctx, "Math.IdentityMany", args, &reply)
+	return reply, err
+}
+
 func (c *Math) IdentityManyStruct(args []*math.IdentityStruct) ([]math.IdentityStruct, error) {
 	var reply []math.IdentityStruct
 	err := c.RPC.Call("Math.IdentityManyStruct", args, &reply)
 	return reply, err
 }
 
+func (c *Math) IdentityManyStructContext(ctx context.Context, args []*math.IdentityStruct) ([]math.IdentityStruct, error) {
+	var reply []math.IdentityStruct
+	err := c.RPC.CallContext(ctx, "Math.IdentityManyStruct", args, &reply)
+	return reply, err
+}
+
 func (c *Math) MapOfPrimitives(args map[string]string) ([]int, error) {
 	var reply []int
 	err := c.RPC.Call("Math.MapOfPrimitives", args, &reply)
 	return reply, err
 }
+
+func (c *Math) MapOfPrimitivesContext(ctx context.Context, args map[string]string) ([]int, error) {
+	var reply []int
+	err := c.RPC.CallContext(ctx, "Math.MapOfPrimitives", args, &reply)
+	return reply, err
+}
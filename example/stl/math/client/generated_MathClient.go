@@ -1,6 +1,13 @@
+// Code generated by glue. DO NOT EDIT.
+//
+// Source: github.com/segmentio/glue/example/stl/math
+// Command: glue -name Service -service Math
+
 package client
 
 import (
+	"context"
+
 	"github.com/segmentio/glue/client"
 
 	"github.com/segmentio/glue/example/stl/math"
@@ -22,6 +29,17 @@ type MathIFace interface {
 	Abs(args math1.AbsArg) (float64, error)
 }
 
+type MathContextIFace interface {
+	Sum(args math.SumArg) (math.SumReply, error)
+	SumContext(ctx context.Context, args math.SumArg) (math.SumReply, error)
+
+	Identity(args int) (int, error)
+	IdentityContext(ctx context.Context, args int) (int, error)
+
+	Abs(args math1.AbsArg) (float64, error)
+	AbsContext(ctx context.Context, args math1.AbsArg) (float64, error)
+}
+
 type Math struct {
 	RPC client.Client
 }
@@ -32,14 +50,32 @@ func (c *Math) Sum(args math.SumArg) (math.SumReply, error) {
 	return reply, err
 }
 
+func (c *Math) SumContext(ctx context.Context, args math.SumArg) (math.SumReply, error) {
+	var reply math.SumReply
+	err := c.RPC.CallContext(ctx, "Math.Sum", args, &reply)
+	return reply, err
+}
+
 func (c *Math) Identity(args int) (int, error) {
 	var reply int
 	err := c.RPC.Call("Math.Identity", args, &reply)
 	return reply, err
 }
 
+func (c *Math) IdentityContext(ctx context.Context, args int) (int, error) {
+	var reply int
+	err := c.RPC.CallContext(ctx, "Math.Identity", args, &reply)
+	return reply, err
+}
+
 func (c *Math) Abs(args math1.AbsArg) (float64, error) {
 	var reply float64
 	err := c.RPC.Call("Math.Abs", args, &reply)
 	return reply, err
 }
+
+func (c *Math) AbsContext(ctx context.Context, args math1.AbsArg) (float64, error) {
+	var reply float64
+	err := c.RPC.CallContext(ctx, "Math.Abs", args, &reply)
+	return reply, err
+}
@@ -0,0 +1,160 @@
+package glue
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"sort"
+
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/provider"
+)
+
+// Verdicts of an Explanation.
+const (
+	VerdictIncluded = "included"
+	VerdictSkipped  = "skipped"
+	// VerdictInvalid marks methods with invalid directives, which fail Walk.
+	VerdictInvalid = "invalid"
+)
+
+// An Explanation describes why a method of an RPC declaration was included in
+// or skipped from the generated client.
+type Explanation struct {
+	// Method is the name of the method.
+	Method string `json:"method"`
+	// File, Line and Column locate the method's declaration.
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	// Verdict is VerdictIncluded, VerdictSkipped or VerdictInvalid.
+	Verdict string `json:"verdict"`
+	// Rule identifies why the method was skipped or is invalid (e.g.
+	// `reply-not-pointer`).
+	Rule string `json:"rule,omitempty"`
+	// Reason describes why the method was skipped or is invalid.
+	Reason string `json:"reason,omitempty"`
+	// Clients are the generated clients that include the method, when
+	// Directions.Clients is set.
+	Clients []string `json:"clients,omitempty"`
+}
+
+// Explain walks the source code like Walk, but instead of generating code it
+// reports the verdict for every method declared on the target declaration,
+// sorted by position.
+func (w *Walker) Explain(directions Directions) ([]Explanation, error) {
+	w = w.rooted(directions)
+
+	clients, err := directions.clientFilters()
+	if err != nil {
+		w.invalidDirections(err)
+		return nil, err
+	}
+
+	prgm, err := w.load(directions.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []Explanation
+	for _, pkg := range prgm.InitialPackages() {
+		visitor := NewVisitor(VisitorConfig{
+			Pkg:         pkg,
+			Provider:    w.Provider,
+			Declaration: directions.Name,
+			Logger:      w.Logger,
+			Reporter:    w.Reporter,
+			Fset:        prgm.Fset,
+		})
+		visitor.Go()
+
+		decl := visitor.Declaration()
+		if decl == nil {
+			continue
+		}
+
+		annotations := visitor.Annotations()
+		for i := 0; i < decl.NumMethods(); i++ {
+			method := decl.Method(i)
+			pos := prgm.Fset.Position(method.Pos())
+			e := Explanation{
+				Method:  method.Name(),
+				File:    pos.Filename,
+				Line:    pos.Line,
+				Column:  pos.Column,
+				Verdict: VerdictSkipped,
+			}
+
+			if err := visitor.AnnotationErr(method.Name()); err != nil {
+				e.Verdict = VerdictInvalid
+				e.Rule = "annotation"
+				e.Reason = err.Error()
+			} else if rejection := w.explainMethod(method); rejection != nil {
+				e.Rule = rejection.Rule
+				e.Reason = rejection.Reason
+			} else if annotations[method.Name()].Skip {
+				e.Rule = "annotation-skip"
+				e.Reason = "annotated with glue:skip"
+			} else {
+				for _, c := range clients {
+					if c.filter.Match(method.Name()) {
+						e.Clients = append(e.Clients, c.name)
+					}
+				}
+
+				if len(e.Clients) == 0 {
+					e.Rule = "filtered"
+					e.Reason = "excluded by method filters"
+				} else {
+					e.Verdict = VerdictIncluded
+				}
+
+				// Clients only matter when several are generated.
+				if len(directions.Clients) == 0 {
+					e.Clients = nil
+				}
+			}
+
+			ret = append(ret, e)
+		}
+	}
+
+	if len(ret) == 0 {
+		w.logger().Error("could not find RPC declaration")
+		w.reporter().Report(diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Rule:     "declaration-not-found",
+			Message:  fmt.Sprintf("could not find RPC declaration %s in %s", directions.Name, directions.Path),
+			File:     localDir(directions.Path),
+		})
+		return nil, errors.New("not found")
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	return ret, nil
+}
+
+func (w *Walker) explainMethod(method *types.Func) *provider.Rejection {
+	if explainer, ok := w.Provider.(provider.Explainer); ok {
+		return explainer.Explain(method)
+	}
+
+	if !w.Provider.IsSuitableMethod(method) {
+		return &provider.Rejection{
+			Rule:   "unsuitable",
+			Reason: "rejected by " + w.Provider.Name(),
+		}
+	}
+
+	return nil
+}
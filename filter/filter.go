@@ -0,0 +1,89 @@
+// Package filter selects RPC methods by name.
+//
+// A pattern is either a glob (e.g. `Admin*`, see path.Match) or, when wrapped
+// in slashes, a regular expression (e.g. `/^(Get|List)/`).
+package filter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// A Filter includes or excludes method names.
+type Filter struct {
+	include []matcher
+	exclude []matcher
+}
+
+type matcher func(name string) bool
+
+// New creates a Filter. A name passes if it matches any include pattern (or
+// there are none) and matches no exclude pattern.
+func New(include, exclude []string) (*Filter, error) {
+	f := &Filter{}
+
+	for _, p := range include {
+		m, err := compile(p)
+		if err != nil {
+			return nil, err
+		}
+		f.include = append(f.include, m)
+	}
+
+	for _, p := range exclude {
+		m, err := compile(p)
+		if err != nil {
+			return nil, err
+		}
+		f.exclude = append(f.exclude, m)
+	}
+
+	return f, nil
+}
+
+// Match reports whether name passes the filter. A nil Filter matches everything.
+func (f *Filter) Match(name string) bool {
+	if f == nil {
+		return true
+	}
+
+	for _, m := range f.exclude {
+		if m(name) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, m := range f.include {
+		if m(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compile(pattern string) (matcher, error) {
+	if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid method pattern %s: %s", pattern, err.Error())
+		}
+		return re.MatchString, nil
+	}
+
+	// Validate the glob up front; path.Match only reports ErrBadPattern lazily.
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid method pattern %s: %s", pattern, err.Error())
+	}
+
+	return func(name string) bool {
+		ok, _ := path.Match(pattern, name)
+		return ok
+	}, nil
+}
@@ -0,0 +1,69 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/segmentio/glue/filter"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		include []string
+		exclude []string
+		name    string
+		want    bool
+	}{
+		{nil, nil, "Sum", true},
+		{[]string{"S*"}, nil, "Sum", true},
+		{[]string{"S*"}, nil, "Admin", false},
+		{[]string{"Sum"}, nil, "SumAll", false},
+		{[]string{"S?m"}, nil, "Sum", true},
+		{[]string{"[A-M]*"}, nil, "Admin", true},
+		{[]string{"Get*", "List*"}, nil, "ListUsers", true},
+		{nil, []string{"Admin*"}, "AdminReset", false},
+		{nil, []string{"Admin*"}, "Sum", true},
+		{[]string{"/^(Get|List)/"}, nil, "GetUser", true},
+		{[]string{"/^(Get|List)/"}, nil, "ForgetUser", false},
+		{[]string{"/User/"}, nil, "ForgetUser", true},
+		{nil, []string{"/Internal$/"}, "SyncInternal", false},
+		// Exclude wins over include.
+		{[]string{"*"}, []string{"Admin*"}, "AdminReset", false},
+		{[]string{"/./"}, []string{"/^Admin/"}, "Admin", false},
+		// A lone slash is a glob, not an empty regexp.
+		{[]string{"/"}, nil, "/", true},
+	}
+	for _, test := range tests {
+		f, err := filter.New(test.include, test.exclude)
+		if err != nil {
+			t.Errorf("New(%q, %q): %v", test.include, test.exclude, err)
+			continue
+		}
+		if got := f.Match(test.name); got != test.want {
+			t.Errorf("New(%q, %q).Match(%q) = %v, want %v", test.include, test.exclude, test.name, got, test.want)
+		}
+	}
+}
+
+func TestMatchNil(t *testing.T) {
+	var f *filter.Filter
+	if !f.Match("Sum") {
+		t.Error("nil filter should match everything")
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	tests := []struct {
+		include []string
+		exclude []string
+	}{
+		{[]string{"[A-"}, nil},
+		{nil, []string{"Admin["}},
+		{[]string{"/(Get/"}, nil},
+		{nil, []string{"/*/"}},
+	}
+	for _, test := range tests {
+		if _, err := filter.New(test.include, test.exclude); err == nil {
+			t.Errorf("New(%q, %q): expected an error", test.include, test.exclude)
+		}
+	}
+}
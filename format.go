@@ -0,0 +1,106 @@
+package glue
+
+import (
+	"sort"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/collection"
+	"github.com/segmentio/glue/generator/docs"
+	"github.com/segmentio/glue/generator/example"
+	"github.com/segmentio/glue/generator/jsonschema"
+	"github.com/segmentio/glue/generator/openrpc"
+	"github.com/segmentio/glue/generator/proto"
+	"github.com/segmentio/glue/generator/python"
+	"github.com/segmentio/glue/generator/typescript"
+)
+
+// Names of the formats supported by default (see Formats).
+const (
+	FormatGo         = "go"
+	FormatOpenRPC    = "openrpc"
+	FormatJSONSchema = "jsonschema"
+	FormatTypeScript = "typescript"
+	FormatPython     = "python"
+	FormatProto      = "proto"
+	FormatMarkdown   = "markdown"
+	FormatHTML       = "html"
+	FormatExamples   = "examples"
+	FormatPostman    = "postman"
+	FormatBruno      = "bruno"
+)
+
+// A Format is an output Walk can generate for each client.
+type Format struct {
+	// Filename is the default Directions.Filename for the format.
+	Filename string
+	// Generate renders a client from the description of its service.
+	Generate func(generator.GenerateInput) ([]byte, error)
+	// Lockfile is set for formats that keep state across runs in a lockfile
+	// (see generator.Lockfile). It's written next to each output file, named
+	// after it with LockfileSuffix.
+	Lockfile bool
+}
+
+// LockfileSuffix is appended to the name of an output file to name its
+// lockfile.
+const LockfileSuffix = ".lock"
+
+// Formats are the formats Walk can generate, keyed by Directions.Format.
+var Formats = map[string]Format{
+	FormatGo: {
+		Filename: DefaultFilename,
+		Generate: generator.Generate,
+	},
+	FormatOpenRPC: {
+		Filename: "{{ .Client }}.openrpc.json",
+		Generate: openrpc.Generate,
+	},
+	FormatJSONSchema: {
+		Filename: "{{ .Client }}.schema.json",
+		Generate: jsonschema.Generate,
+	},
+	FormatTypeScript: {
+		Filename: "{{ .Client }}.ts",
+		Generate: typescript.Generate,
+	},
+	FormatPython: {
+		Filename: "{{ .Client }}.py",
+		Generate: python.Generate,
+	},
+	FormatProto: {
+		Filename: "{{ .Client }}.proto",
+		Generate: proto.Generate,
+		Lockfile: true,
+	},
+	FormatMarkdown: {
+		Filename: "{{ .Client }}.md",
+		Generate: docs.GenerateMarkdown,
+	},
+	FormatHTML: {
+		Filename: "{{ .Client }}.html",
+		Generate: docs.GenerateHTML,
+	},
+	FormatExamples: {
+		Filename: `{{ if eq .Provider "gorilla" }}{{ .Client }}.examples.json{{ else }}generated_{{ .Client }}Examples.go{{ end }}`,
+		Generate: example.Generate,
+	},
+	FormatPostman: {
+		Filename: "{{ .Client }}.postman_collection.json",
+		Generate: collection.GeneratePostman,
+	},
+	FormatBruno: {
+		Filename: "{{ .Client }}.bruno.json",
+		Generate: collection.GenerateBruno,
+	},
+}
+
+// FormatNames returns the names of Formats, sorted.
+func FormatNames() []string {
+	names := make([]string, 0, len(Formats))
+	for name := range Formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
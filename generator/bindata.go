@@ -1,6 +1,14 @@
 // Code generated by go-bindata.
 // sources:
 // templates/client.gohtml
+// templates/docs.html
+// templates/docs.mdhtml
+// templates/examples.gohtml
+// templates/inprocess.gohtml
+// templates/mock.gohtml
+// templates/proto.protohtml
+// templates/python.pyhtml
+// templates/typescript.tshtml
 // DO NOT EDIT!
 
 package generator
@@ -68,7 +76,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templatesClientGohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\x4d\x8b\xdb\x30\x10\xbd\xeb\x57\x3c\x4c\x29\x76\x31\xca\xbd\x90\x43\x31\x14\x72\x68\x08\x69\xff\x80\xaa\x4c\x1c\x53\x5b\x36\x63\x39\x1f\x18\xfd\xf7\x22\xc9\x6c\xe2\x90\x85\x65\x37\xec\x4d\x9a\xaf\xf7\xde\xcc\xeb\x94\xfe\xa7\x4a\xc2\x38\x42\x6e\xa6\xb7\x73\x42\x54\x4d\xd7\xb2\x45\x2a\x80\x44\xb7\xc6\xd2\xd9\x26\xc2\x7f\xca\xca\x1e\x86\xbf\x52\xb7\xcd\xa2\xa7\xb2\x21\x63\xab\x76\x51\xd6\x03\x2d\x74\x5d\x91\xb1\x89\x80\x1f\xc6\xca\x94\x04\xb9\x0a\x63\x7a\x3f\x12\x08\x09\xb9\x56\x8d\x87\x40\x12\x21\xed\x01\xce\x4d\x4d\x64\x76\xbe\x32\x13\x62\x3f\x18\x8d\x35\x9d\x7c\xcd\x6f\xe2\x63\xa5\x7d\x4f\x11\x10\x52\xee\x74\x7c\x21\x42\xca\xf8\xcb\xf0\x6d\x5e\x8e\x51\x00\x1a\xdf\x97\x30\x74\x4a\xe7\xb9\xcc\xa7\xe4\x76\x53\x60\x89\x97\x79\x02\x60\xb2\x03\x1b\x68\xe1\x84\xb0\x97\x2e\x2e\xe6\xda\xb6\xfa\xa9\x34\xa1\x32\x96\x78\xef\x5f\xe3\x4c\xed\x2f\xb2\x87\x76\xf7\x48\x6d\xaa\xb8\xec\x43\xe0\x07\x97\x7f\xfc\x5c\xe7\x32\x04\x4e\x5b\xea\xea\xcb\x14\xca\x41\xcc\x2d\x67\xb3\x75\x3c\x66\x52\xc4\xa3\x7c\x1e\xa1\x59\xfb\x84\x9e\x6a\x7b\xc6\x64\x0f\x39\xc5\x72\x3c\x5d\x2b\x7a\xcb\x83\xb6\x41\x9c\x3f\xd9\xec\xec\xbe\xe7\x15\xc5\xc1\x45\xa9\x0e\xbe\xf8\x72\x7b\xfc\x0f\x6c\x22\x90\x00\x8e\x8a\xc1\x3e\x8d\xfb\xc2\x90\x26\x66\xef\xbb\xe0\x30\x59\xa8\xba\x4e\x93\x3b\x0e\xf2\x86\x42\x12\x77\x96\xe3\x6b\x18\x19\xb7\x3d\x39\x31\x44\x02\xb8\x00\x9c\x78\xab\xaa\x27\x1e\xe8\x9d\x8a\x6f\x18\xe4\x78\x92\xfa\xab\x51\xfe\x07\x00\x00\xff\xff\xf8\xa9\x24\xc3\xb8\x04\x00\x00"
+var _templatesClientGohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x56\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x3c\x08\x45\x21\x15\x0e\x75\x0f\xb0\x87\xd6\x69\x51\x1f\x36\x6b\x64\x83\xf6\xac\x50\x63\x99\x8d\x44\xaa\x23\x2a\x89\x21\xe8\xbf\x17\xa4\x28\x59\xb6\xe3\xb6\x68\x53\xa0\x27\x91\xf3\xf9\xe6\xcd\x90\x62\xdf\xa3\xa0\x9d\xd2\x84\xb8\x30\x32\xc6\xcd\x30\x44\x40\xdf\x43\xed\x20\xee\xa8\x61\x92\xb9\xa5\x22\xc8\x67\xcd\xa6\xa0\xba\x31\x96\xb4\x9d\x35\x40\x96\xe1\x28\xbf\x45\x9b\xef\x08\xd6\x80\xc9\xf2\x41\xcc\x36\x53\x18\xd2\xc7\xa8\x59\x86\x63\xae\xdb\xf3\xf4\xca\xe8\x7b\x63\x09\xc3\xd0\xf7\x57\xa4\x54\xb5\x93\xfe\x3e\xaf\xdd\x12\xaa\x45\x31\xc7\x14\x21\xe1\x54\x9d\xb7\xbf\x52\xc8\x5f\x95\xb1\xc0\xbe\x58\x46\x59\x86\xb5\x29\x08\x25\x69\x62\x97\x13\x4f\x07\x94\x55\x47\x7d\x8f\x57\x65\xf7\x10\x3f\x53\x5e\x10\x8b\x5f\x88\x5b\x65\x34\x86\x01\x0e\x6f\x28\xc0\x83\x13\xb8\xfb\x82\xfb\x2f\x8f\xf8\xf1\x6e\xf3\x28\xa2\xbe\xbf\x71\x20\x0d\xcf\xbe\x5f\x4d\xc7\x92\xe6\xed\xda\xd4\x75\x3e\x96\x95\x65\xde\xfc\x24\x53\xb0\xf6\x5a\x8c\x9b\xdb\x29\xa7\xb7\x0e\x94\x5c\x38\x9e\xc4\x45\xd8\x5d\x75\x0d\xcb\xa8\xc9\xe5\x73\x5e\x92\x37\xdb\x86\xb5\x93\xab\xba\x31\x6c\x91\x44\x40\x2c\x8d\xb6\xf4\x66\x63\xcf\xa3\xaf\x4e\x7c\x36\xf2\xb9\x1d\x3b\x13\xb7\x07\x2d\x27\xdd\xa2\x5f\xa3\xe1\x46\x6f\xd9\x48\x6a\x27\x63\x62\x36\xdc\x2e\x42\xd1\xef\x10\x5b\x36\x2f\xaa\x20\x46\x5c\x1a\x56\x55\x95\xc7\x93\xb5\x96\xa6\x50\xba\xcc\x7e\x6b\x8d\x76\x4e\xb1\x26\x9b\xed\xad\x6d\xe6\x84\xe3\x10\x39\xd5\xd3\xc1\x52\x1b\x9f\xb8\x95\xe6\x69\xf6\xe2\xe6\x7d\x94\x61\xe3\xcc\x4a\x65\xf7\xdd\x93\x90\xa6\xce\x5a\x2a\x6b\xd2\x56\x99\xcc\x8d\x43\x26\x2b\x45\x3a\x30\x00\xce\x75\x49\x10\x1b\x4f\x51\x28\x0c\x58\xce\x71\x3c\xd2\x69\xf7\x18\x86\xf8\x38\x7e\xc3\x10\xa5\x51\xb4\xeb\xb4\xc4\x3d\xbd\x3a\x9b\x4d\xe1\x92\xec\x14\x31\x86\x61\xed\x93\x24\xdc\xc8\x71\x85\x31\xab\x18\x77\x29\xbe\xbb\xf0\x40\x1f\x01\x12\xb7\x9f\xa0\xe9\x35\xb9\x50\xa7\x4e\x2b\x1e\xb6\x6b\x7c\xc2\x1c\x35\x82\x3b\x16\x1d\x6b\xc8\x68\x88\x22\x7b\x68\x08\x17\x9e\x9b\x9f\x72\x49\x50\xda\x12\xef\xdc\xaa\x0f\x64\x85\xd2\x3f\x93\xdd\x9b\x62\x59\xba\xa5\xba\xa9\x72\x3b\x5d\x49\x02\x37\x97\xb4\x24\x39\x97\xad\x17\x7c\xcf\xe5\xa3\xcb\x3b\x0c\x29\x3c\xec\x07\x6a\xaa\x43\x10\xad\xe0\x87\x24\x3d\xe1\xed\x2a\xd2\xf5\x38\x9c\xff\x13\xc0\x7f\x3b\x74\x80\x9d\x48\xfb\x86\x70\xbe\x44\x90\xad\xf0\x5f\xf0\x84\xd6\x72\x27\xad\x27\xc6\x4d\xc4\xc9\x6c\x39\xb7\xbe\x7f\x97\xac\xeb\xf5\xf8\x39\x4e\xa4\x1f\xcb\x6f\xce\x06\xef\x5f\xb0\xe8\x11\x1e\x2f\x90\x47\x55\x93\xe9\xec\xd4\xb9\x69\x74\xc5\x7b\x5c\x8e\x5f\xf1\x43\x2e\x9f\x4b\x36\x9d\x2e\x92\x74\x24\x33\x9d\x43\x1e\xef\x0b\xe0\x25\x67\xb0\x43\x80\x73\x2c\x5e\x4d\xcc\xee\x64\xf9\x03\x24\xd6\x79\x55\x25\xb1\xaf\xf4\x2b\xf1\x8b\xf2\x57\xb4\x07\xf1\xb0\x5d\x07\x1c\xf1\x98\x6c\x85\x6f\x7d\xd4\x74\x09\xd8\x4b\x7c\x89\x47\x28\xd3\x2d\x34\x44\x1f\xc2\xf3\x07\x8e\xd4\x9f\xf7\x40\xda\xb7\x15\x64\xae\x25\x55\x9e\xa0\x90\xeb\x57\x65\xf7\xc1\x32\xf1\x26\x7d\x8f\xa2\x63\xff\xd7\x5f\xc6\x18\x79\x29\x68\x47\x1c\xa2\x24\xe9\xf2\x81\xf1\x0f\xbb\xb3\x28\x7f\x85\x8f\xea\xd4\xfc\x5c\x18\x1f\x0e\xcb\x5f\xdf\x49\xc3\x6a\x23\x9f\xe3\xd3\x77\xc1\x64\xbf\xfc\x03\x9e\xf8\x28\xdd\x8c\x8a\x73\xc7\x3f\x06\x00\x17\xc7\x88\xb5\xe0\x09\x00\x00"
 
 func templatesClientGohtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -83,7 +91,167 @@ func templatesClientGohtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "templates/client.gohtml", size: 1208, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	info := bindataFileInfo{name: "templates/client.gohtml", size: 2528, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocsHtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x56\x5b\x6f\xd4\xba\x16\x7e\x9f\x5f\xb1\x4e\xda\x07\x90\x72\x99\x19\x4a\x81\x8c\x1b\x89\x43\xb9\x1d\x51\x40\x2d\x3c\x9c\x47\x13\xaf\x24\x16\x8e\x9d\x63\x7b\x4a\xe7\x44\xf9\xef\x5b\x76\xae\x03\x74\xef\xad\x4a\xfb\x69\xe2\xb5\xbe\x75\xff\x96\x3d\xe4\x5f\x97\x9f\x5e\x7d\xf9\xef\xe7\xd7\x50\xd9\x5a\x64\xab\xb6\x85\x0a\x29\x43\x0d\xf1\xbb\xfe\xb7\xeb\x56\xc4\xe9\x40\x50\x59\x5e\x04\x28\x83\x6c\x45\x1c\x26\x5b\x91\x1a\x2d\x85\xbc\xa2\xda\xa0\xbd\x08\xf6\xb6\x88\x9e\x3b\xad\xe5\x56\x60\xd6\xb6\x10\x7f\x71\x5f\xd0\x75\x24\xe9\x65\x2b\x62\xec\xc1\xfd\x7e\x53\xec\x00\x2d\x14\x4a\xda\xa8\xa0\x35\x17\x87\x14\x22\xda\x34\x02\x23\x73\x30\x16\xeb\x10\xfe\x2d\xb8\xfc\x7e\x45\xf3\x1b\x7f\x7e\xa3\xa4\x0d\x21\xb8\xc1\x52\x21\x7c\x7d\x1f\x84\xf0\x0e\xc5\x2d\x5a\x9e\xd3\x10\x5e\x6a\x4e\x45\x08\x86\x4a\x13\x19\xd4\xbc\xd8\x81\xe0\x12\xa3\x0a\x79\x59\xd9\x14\x36\xf1\xd3\x1d\xd4\xf4\x2e\xfa\xc1\x99\xad\x52\x38\x5f\x63\xed\x04\xba\xe4\x32\x85\x35\xd0\xbd\x55\x3b\x68\x28\x63\x5c\x96\x29\x6c\xb0\x86\xad\x43\xe4\x4a\x28\x9d\xc2\xc9\xf6\x6c\xfb\x62\x5b\xec\xa0\x5b\xe5\x8a\x61\x08\x8d\xc6\x9f\x93\xdf\xf3\xa8\x56\x52\x99\x86\xe6\x18\xc2\xcd\x9b\x2b\x25\x55\x74\x8d\xe5\x5e\x50\x1d\xc2\x15\x4a\xa1\x42\x78\xa5\xa4\x51\x82\x9a\x10\x26\xec\xae\x77\x63\xf8\xff\x31\x85\x75\xfc\xc2\x85\xed\x56\x7d\x80\x6f\x34\xff\x5e\x6a\xb5\x97\x2c\x85\x93\xe2\xbc\x78\x5e\xd0\xe3\x2c\x77\xa0\x6e\x51\x17\x42\xfd\x48\x87\x1a\xba\x95\xa5\xdf\x84\x37\x56\x9a\xa1\x8e\x72\x25\x04\x6d\x0c\xa6\x30\x7e\xcd\x85\xbb\x3a\xd7\xde\xa6\x0a\xc1\xb2\xc9\x28\x85\x4d\x73\x07\x46\x09\xce\xe0\x84\xad\xd9\x33\x86\x8b\xb8\xeb\xf8\x89\xb3\x8b\x9f\xbb\xf8\x16\xef\x6c\x44\x05\x2f\x65\x0a\x02\x0b\xbb\x83\x5b\xd4\x6e\x28\x62\x94\x5a\xd5\xb8\x10\x31\xc3\x46\x63\x4e\x2d\xce\x71\x22\x67\x91\xc2\xd9\x22\xd8\x66\x7b\xb6\x2d\xa6\x60\x03\x60\xb3\x9c\xc5\xd3\x67\xe7\xeb\x73\xea\x5d\xaa\xc6\x72\x25\xa9\x80\xf6\x37\x5a\x92\x0c\x54\x23\xc9\xc0\x56\xc7\x39\xc7\xdd\xcd\x4f\xd4\xac\x36\x8e\xf7\x11\x68\x2a\x4b\x84\x86\x6a\x5a\x6a\xda\x54\x06\xe2\x4b\x95\x7b\xfe\x37\xde\xc2\x83\x9b\x1e\x8b\x92\x0d\x9a\xcf\x34\xff\x4e\x4b\x04\xe2\xa8\xe1\x71\xa3\xc4\xc1\xbd\x30\x04\x83\xfa\x16\x19\xfc\xe0\xb6\x02\x0f\xd1\xea\x96\xf7\xdb\x15\x7b\x9f\x2b\x52\x6d\xb3\x2b\xb4\x95\x62\x86\x24\xd5\x36\x5b\x91\xbd\x58\xa6\x15\x0f\x4a\x1f\x55\xf0\x8c\x50\xa8\x34\x16\x17\xc1\x49\xdb\x02\x95\x79\xa5\x34\xc4\xd7\x9f\x5f\x41\xd7\x05\x3e\x8b\xfe\x9b\x24\xd4\x9d\x78\x01\xf1\xe5\x3c\x80\xae\x83\x47\xf3\x3c\x1e\xb7\xed\x50\x0f\x49\x04\x3f\xae\x2f\xb9\x3f\x8b\x15\xa9\x9e\x00\x67\x17\xc1\x5f\x24\x50\x3d\xe9\x3d\xfc\x92\xc3\x8a\x34\x90\x0b\x6a\xcc\x45\x30\x27\x13\x64\xc4\x58\xad\x64\x99\xcd\x58\x92\x0c\xa2\xb6\xed\x5b\x38\xf9\xe1\x4a\x7e\x54\xd6\xb5\x3a\x85\x61\x44\x8b\x62\x8e\x67\xf5\xc0\x11\x0f\xa9\xbb\xea\xde\x33\xac\x1b\x65\x51\x5a\x47\x9f\x1a\xd5\xde\xce\xb6\xbc\x38\x02\x74\xdd\x7c\x48\xc1\xd0\x02\xc1\x2a\xd0\x68\xf5\x21\x9e\x52\xec\xcd\xa8\x64\xf7\xf9\x86\x25\xb4\x2f\x7d\x56\x0e\x5f\x53\xe5\xf1\xbd\xa5\x93\xea\x2c\x7b\xa9\xcb\x7d\x8d\xd2\x3a\x7a\x9d\xf5\x5a\x8b\x75\x23\xa8\x45\x08\xec\xa1\xc1\x00\xe2\x97\xba\x1c\xe1\xd7\xd8\x88\xc3\x9f\x40\xbd\x7e\x04\xbf\xbe\xa3\x75\x23\xb0\x87\x93\x26\xbb\xc6\xff\xed\xd1\xd8\xd4\xe7\x41\x1a\x8d\x99\xdf\x8e\x71\xda\xee\x25\xd9\xd3\x12\x23\x97\xf8\x87\xe1\x30\xf3\xa6\x37\x9e\xd7\x87\x24\xce\x43\xef\xd7\x34\x4a\x1a\x7c\xa8\xe3\xde\xfa\x17\xcf\x3f\x4d\x7b\xe8\xf3\xa1\xc1\x91\xe7\xdb\xcc\x9f\xfa\xc5\x5c\x6c\xc3\x7d\x6b\xf0\x91\xd6\x73\xd8\xe1\x30\x2f\xc2\x03\x49\x38\x8f\xa0\xe0\x28\x98\x09\x20\x1e\x55\x0b\xd4\xb4\xb8\xc3\x6d\x97\x4c\xef\x3a\xc3\x82\x4b\x84\xc0\x3d\xab\x26\xe8\x29\xd5\xa7\x72\xca\x43\x38\x95\x2e\xcd\xf4\x62\xdc\x21\x5e\xc0\x29\x87\xae\x0b\x3d\x05\x85\xef\x1a\x3c\x9a\x19\xf6\x9b\xeb\xe7\x54\x2e\xca\x1e\x0f\xc3\x05\xb4\xe4\xbb\x8b\xf0\x78\x29\x5b\xa4\x3f\x26\xd9\xb3\xac\xef\xc9\x7c\xb3\xbe\x55\x6e\x0e\xf3\xfc\xda\x76\xd1\x95\xa1\xae\xf8\x83\xfb\x5d\xf6\xf0\x1f\xed\xf7\x98\xf0\x88\x99\x6f\x8c\xf8\x8d\x17\xf9\x40\xfe\x51\x76\xff\x8c\x74\x46\x6c\x95\x79\x0d\x49\x6c\xe5\x4f\x6f\x15\xb8\x72\xa7\xf3\x7f\x6e\x3e\x7d\x9c\x0e\x97\x68\x72\xcd\xfd\x43\xd7\xcb\x12\xab\x8f\x38\xb8\x8c\xe2\xbc\xb3\x45\xbf\x26\xea\x0d\x6c\x77\x4a\xcb\x1e\xde\xd0\x5f\xec\x5d\xaa\x47\xd6\xae\xee\x4f\xe3\xb3\xdc\x75\x40\x4c\x43\xe5\xb8\x9e\xe3\x7b\x1d\x64\x8f\xc6\xcf\xc7\x24\x71\x88\x6c\x71\x75\x0d\x41\xda\x76\x9c\x53\x2f\x9a\xea\x9e\x18\x3e\x34\xd5\xcb\x1c\x41\x5d\xec\xaf\x92\xa1\x16\x07\x2e\xcb\x61\xc0\x97\x7e\x3e\x0c\xa8\x59\xbc\xd0\x47\xa8\xbf\x53\x7a\xfc\x3b\x82\x0c\x9f\x7f\x0c\x00\x12\x41\xe1\x08\x43\x0b\x00\x00"
+
+func templatesDocsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDocsHtml,
+		"templates/docs.html",
+	)
+}
+
+func templatesDocsHtml() (*asset, error) {
+	bytes, err := templatesDocsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/docs.html", size: 2883, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDocsMdhtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xb4\x53\xcd\x6e\xdb\x3c\x10\xbc\xf3\x29\xe6\xb3\x73\x48\x8c\x48\x0f\x60\x7c\x0d\x10\xc4\x69\x9a\x22\xb5\x83\xc4\xed\xa5\x28\x20\xd5\x5a\x3b\x44\x24\x52\xa5\xe8\xb4\x86\xc4\x77\x2f\x48\xea\xc7\xb2\x95\xa0\x3d\xf4\x62\x93\xbb\x3b\xab\xd9\x9d\xe1\xff\xff\x05\x01\xae\x64\x42\xd8\x90\x20\x15\x6b\x4a\xf0\x7d\x87\x4d\xba\xa5\xb2\xc4\x4f\xae\x9f\x10\x7e\xa0\x38\x21\x15\x7e\x21\x55\x70\x29\x60\x0c\xca\x12\x21\x8c\x29\x4b\x90\x48\x60\x4c\x88\xd9\x02\xf3\xc5\x12\xd7\xb3\xdb\x65\xc8\xca\x32\xe8\x43\x1f\xe5\x56\xad\x08\xc6\x30\x7f\x9a\x36\x1d\x5c\xa9\xef\x71\x8c\xba\x92\x59\x16\xfb\x5c\x7d\x1c\xc4\x05\xc1\x05\x63\x63\x97\x59\x72\x9d\x52\xbf\xd7\x4c\xae\x6c\x80\x0d\x00\xd9\x7d\xbc\x7a\x8e\x37\x84\xc8\x26\x9b\x8b\x31\xd1\x39\x0a\x52\x2f\x94\xf8\x16\x2e\xa9\xe4\x0b\x4f\x48\xd9\x59\x19\x1b\x8f\xf1\x89\xf4\x93\x4c\x0a\xdb\x56\xc5\x62\x43\x08\xeb\x88\x63\x84\xaf\x16\xf4\x70\x7f\x05\x63\xbe\x9d\x8e\xcb\x12\xb1\x58\x3d\x49\xd5\xc4\xce\xca\x12\x7c\x8d\x70\x46\xb9\xa2\x95\x5b\xba\x31\x38\x4d\xda\xeb\x59\xbb\xda\xc3\x0d\x1d\x7f\x8d\x8d\xc7\x7e\x7a\xdf\xdb\x55\x1d\x35\x67\xec\x02\x93\x49\x17\x9a\x4c\x5a\x79\x9b\x20\x97\x62\x2e\x35\xc1\x98\xe9\x91\xc0\xc3\x3a\xbd\xb1\xdb\x9a\x84\x1d\xf9\x36\xa1\x2c\x97\x9a\x84\xb6\x02\x65\x24\xb7\xba\x41\xf1\x75\x2f\x6d\x4c\x77\x99\xa2\x88\xd7\x04\x2d\xa1\x48\xab\x5d\xd8\x52\xf1\x30\xeb\x8b\x57\x3a\x63\xbf\xd4\x13\xed\x92\xf5\xa9\x9d\x30\x1c\x1c\xd1\xae\x74\x8c\x4b\xb5\xd9\x66\x24\xb4\x53\x59\x53\x96\xa7\xb1\x26\x8c\xf4\x2e\xa7\x11\xc2\x4b\xb5\xe9\x4a\x1f\x28\x4f\x77\x83\x65\x2e\xd3\x15\x5e\xff\x8a\xb3\x3c\x25\xc6\x1e\xe8\xc7\x96\x0a\x3d\x65\x2c\x8a\x9c\xff\xee\x62\xb1\xd9\x7a\x03\xba\x85\xd6\x05\xf6\x1a\x45\x91\x05\x14\xb9\x14\x05\xbd\x85\xf0\x15\x0d\x64\x50\xb3\xe5\x2e\xa7\xc6\x36\x70\x97\x7d\x5b\xf5\xfc\x34\x8f\x33\xfa\x2b\xc1\xbb\xe1\xd7\x9c\xd2\xa4\x18\x0d\x56\xb5\x47\x24\xb4\xe6\x82\x30\x4a\xb9\x78\x2e\x46\x5e\x30\x4f\xe4\x84\x9f\xe3\x44\xd8\xef\x4f\xdf\x35\x4e\xe4\x6b\x9c\x70\x18\x73\xee\x04\x4e\xdd\x9c\x38\x6d\xf5\xb3\x4f\xce\x43\x0e\xde\x5c\x13\x3c\x3b\xb0\x50\x78\x18\xdb\xe3\xd8\x30\xf3\x22\xda\x7d\xda\x91\x6f\xa4\x5d\x18\x8c\x89\x7a\x4a\xd7\xf4\xc3\x3b\xfb\xff\x0f\x16\xd6\x90\x69\x6a\xf6\xde\xf8\x7b\x17\x72\x9f\xa8\xe0\x2e\xa8\x70\x23\x61\x79\xa3\xc2\xc7\xc7\xc5\x1c\x15\x66\x54\xac\x14\xcf\xed\x0b\x47\xc5\x2a\x04\x41\x80\x81\xdf\x7d\x23\x74\x8d\x2b\x44\x7b\x66\x88\xe0\xef\x2b\x4a\xd3\x3f\x5e\x48\x0f\xe3\x38\x79\x84\x9d\x60\xe1\x68\xc5\xa9\x13\x53\xd6\x97\x4e\x16\x54\x68\x91\x7e\x99\x35\xcf\x7d\x43\x59\x2f\xd8\x5e\x9f\x45\x42\x2a\xdd\x71\xe1\x5f\xe6\xcc\xed\x2d\x41\x5c\xf8\x11\x7a\xe9\xb7\x19\x87\xaf\x98\xf6\xf7\x00\x34\x36\x05\xbf\x2f\x07\x00\x00"
+
+func templatesDocsMdhtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDocsMdhtml,
+		"templates/docs.mdhtml",
+	)
+}
+
+func templatesDocsMdhtml() (*asset, error) {
+	bytes, err := templatesDocsMdhtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/docs.mdhtml", size: 1839, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesExamplesGohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x74\x51\x4f\x4f\x83\x30\x14\xbf\xf7\x53\xfc\xb2\x93\x1e\x56\xee\x26\x9e\xd8\x12\x77\x19\x8b\x92\xdd\xeb\x78\x30\x22\x50\x52\x40\x5d\x9a\xf7\xdd\x0d\xaf\x15\xb7\xa8\xb7\xfe\xfe\xb7\x69\x92\x20\xb5\x05\xa1\xa2\x8e\x9c\x19\xa9\xc0\xeb\x05\x55\x33\x91\xf7\xf8\xa8\xc7\x33\xf4\x13\x99\x82\x9c\x3e\x92\x1b\x6a\xdb\x81\x19\xde\x43\x83\xd9\x7b\x50\x57\x80\x59\x63\x93\x61\x9f\xe5\xd8\x6e\x76\xb9\x56\xde\xaf\x51\x97\xb0\x6e\xc9\xbe\xd8\xc9\x9d\x68\x81\xa9\x6d\x5b\x23\x49\x95\x24\x62\xbf\x59\x8a\x6e\x51\x11\xc0\xc3\xf7\xa6\xb8\xc3\xea\xef\xe0\x4d\x2f\x22\xfa\x37\x1a\x8f\xaa\x37\xa7\x37\x53\x91\xd8\x0e\xf1\x3c\xf3\x75\xdb\x5b\x37\xe2\x4e\x61\x96\x9c\xe9\x2a\x82\xde\x09\x39\x60\xcd\xac\x00\x51\xf4\xde\xb4\x73\x02\xab\xd0\x30\x9e\xc1\xbc\x0a\xa9\xb8\x71\xaf\x7e\x1a\xb6\x9f\xa6\xed\x1b\x1a\xe2\x2d\xaf\x0b\xea\x01\xa6\x03\x05\x83\x28\x59\x39\xf3\xb6\x14\xf0\x7c\x48\xc1\xac\xd5\xbb\x71\xd7\xb1\xe5\xa3\xf2\x4b\x4f\x7f\x7d\x0f\x1e\x85\x3a\x9a\x66\xa2\xf0\x78\x11\xe6\x27\x7c\x0d\x00\xb6\x33\x9a\xb6\xfe\x01\x00\x00"
+
+func templatesExamplesGohtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesExamplesGohtml,
+		"templates/examples.gohtml",
+	)
+}
+
+func templatesExamplesGohtml() (*asset, error) {
+	bytes, err := templatesExamplesGohtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/examples.gohtml", size: 510, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesInprocessGohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\xcc\x4f\x58\xe4\x27\xa5\x0a\xdd\xb3\x17\x39\x6c\xd2\x00\xdd\x43\x82\x20\x9b\xa2\xc7\x82\x26\x47\x12\x1b\x99\xd4\x0e\xa9\x38\x86\xa0\xef\x5e\xf0\x8f\x65\xc7\x9b\xb8\x29\xf6\x64\x89\x22\xdf\xbc\x79\xf3\x66\xe8\x71\x04\x89\xb5\xd2\x08\xb9\xd2\x3d\x19\x81\xd6\xe6\x30\x4d\xd9\x38\x5e\xc0\xa7\xc6\x90\xea\x3a\x0e\xcb\x4b\xc0\xef\xc0\xee\xc9\x3c\x2b\x89\x04\x79\xfa\x10\x76\x2e\x16\xf0\x55\xdf\xc7\xa3\xe3\x08\xec\xab\x44\xed\x54\xad\x90\x60\x9a\x40\x59\xe0\x20\x3a\x85\xda\xb1\xeb\xf0\x03\xae\xe5\x0e\x04\xef\x3a\x0b\xae\x45\x58\xa3\x6b\x8d\xb4\x60\x6a\xe0\x1e\xcc\x63\x7c\x43\x7a\x8e\xe7\xa5\x22\x14\xae\xdb\x56\xb0\x51\xae\x35\x83\x03\x0e\x1a\xdd\xc6\xd0\x53\x05\xb5\x21\x70\x68\x9d\x65\xd9\x62\x11\x28\xab\x7a\xcf\x3a\x72\xfb\x42\x8d\x05\xae\x25\x10\xf6\x9d\x42\x0b\x9c\x10\xc8\x0c\x5a\x5e\x38\x52\x7d\x8f\x12\x5c\x4b\x66\x68\x5a\x40\x2d\x8c\x54\xba\x59\xfc\x6d\x8d\x06\x67\x40\x70\x27\xf6\xcb\x1e\x6d\x35\x34\xb6\x0a\x70\x3b\xda\x84\x02\xd5\x33\x02\x07\xbb\xd5\xae\x45\xa7\x04\x9c\xb7\xce\xf5\xec\x01\xbf\x0f\x68\x7d\xaa\x44\x5b\xa5\x9b\x90\xad\x30\xda\xe1\x8b\x03\x53\x7b\xb8\xb0\xc2\xbb\x8e\x05\xf2\xd8\x59\xfc\x09\xd6\x8d\x59\x55\xc0\xad\x97\x67\x41\xbd\x00\x69\xd0\x56\x21\xca\x71\x26\x21\x8d\x14\x53\x4b\x1f\xd2\x6d\x7b\x3c\x55\x46\xeb\x68\x10\x0e\xc6\x0c\x20\xd5\xe6\xfc\x55\x9d\xb2\x29\xcb\x9e\x39\xc1\x5f\x47\xb5\xbe\x84\xe2\xfc\x7d\xd8\xb2\xd0\xaa\x2b\x33\xcf\xf1\x0e\x37\x27\xc2\x0b\x42\xee\xbc\x0a\xfa\x14\x49\xaf\xa4\xcf\xce\x06\x56\x2c\xab\x07\x2d\x4e\x03\x17\xf6\xad\x64\x4a\x38\xc1\x39\x68\x40\xe8\x06\xd2\x70\xf6\xfe\xb6\x31\xa2\x2d\x13\x99\x20\x50\xe0\x53\x88\x53\xe8\x25\x5c\xf3\xae\x2b\xa2\xb9\xbc\xea\x4a\x37\x15\x70\xef\x06\xa5\x1d\x52\xcd\x05\x8e\x53\x15\x6c\xb1\x3d\x5c\x2a\x01\x89\x0c\x1d\xb2\x13\xcc\x63\x5d\x47\xc3\x15\xc9\x78\xec\x8a\x8b\xa7\x26\x38\xa9\x28\xab\xe4\xe2\x18\x21\xa1\x96\xff\x89\xea\x0c\xef\x5e\x76\xde\x66\x69\xad\x82\x9f\x4b\x43\xd5\xfe\xc5\x0f\x1f\xe1\x5e\xd8\x0d\x51\x51\x7e\x0e\x2b\xff\xbb\x04\xad\xba\xb0\x67\x4e\x16\x89\x32\x80\x29\x03\x78\x63\x0c\x64\x00\x8b\x05\x3c\xb6\x08\x94\x7a\xd2\x37\x5e\xaa\xfd\xc6\x0c\x9d\x84\x96\x3f\x23\x48\x14\x46\xa2\x9c\xdb\x12\x6a\x32\x6b\x96\x01\x50\xb5\xa3\x12\x3a\xfb\x0e\x37\xa9\xb9\xff\x54\xae\x3d\x90\xa0\x8a\xdf\x6f\x43\xde\xf7\xc6\xba\x0a\xf2\x45\x9e\x56\xef\xcc\x95\x91\xdb\x72\x9f\xd9\xe9\x3c\x88\xfd\x8e\x5c\x22\xb1\x6f\xe8\x8a\x3c\x04\xd1\xee\xe2\x71\xdb\x63\x5e\x41\xce\xfb\xbe\x53\x82\x3b\x65\x74\x18\x57\x79\x99\x52\x4f\x0d\x9d\x01\xd8\x8d\xf2\x4d\x9f\x8a\x30\xa6\xef\xc4\x75\x83\x90\x28\x5a\xbf\x15\x40\x70\x8b\x90\x8f\x23\x7c\x0a\x4d\xa0\x04\xc2\x34\x31\x5f\xf1\x87\xfb\xeb\x3b\xbe\xf6\xaf\xf9\x32\xd0\xf4\x4d\xce\xa9\x09\x53\xfa\x0b\x35\x9e\x4e\xc4\x78\x55\x2f\x16\x0c\xf6\x48\xaa\x2f\xce\x38\xa5\xd2\xbf\x55\xbd\xa3\xbc\x63\xe6\x73\x0d\xfd\xa5\xf3\xe0\x2d\x72\xcb\x9f\x10\xf2\x35\xef\xf3\x5d\x30\x7f\x13\x2c\x2f\x61\xcd\x9f\xb0\x18\xc7\xb4\x2d\xb1\x29\x67\x8c\x30\x51\x7f\x04\xb2\x9d\x12\xf8\x21\xa8\x0a\x7e\x3d\x42\x9b\xa6\x59\x07\x7f\xf0\xf8\xc0\x7e\xb3\x96\xbb\xd7\x43\x61\xe2\x58\x38\xd2\xd6\x87\x7d\x6d\x59\xaa\x3c\x72\xc4\x88\x1f\xbd\xda\xf7\x26\xb4\x0a\x4c\xd3\xd9\xfc\x35\xc8\x7b\x66\x06\x77\x52\x5e\xc1\xa2\xdb\x6f\x88\x0c\x15\x48\x54\x1e\xa8\x3d\xef\xd9\x57\x2d\x34\x66\xc2\xcd\x8e\x12\x0a\xe6\x7a\x0f\xd7\x90\xf5\xed\x51\xe4\xd4\x8b\x25\x08\xae\xff\xef\xa0\x56\xf3\x7d\x09\x39\xfc\x92\x1e\xcb\x30\x68\x16\x0b\x98\xa3\xc6\xfb\x09\x2d\x58\x12\xe1\xf2\x8b\xfd\x68\x41\x39\x3f\x23\x0c\x48\xeb\xd8\xc7\x46\xd3\x3e\x13\xe9\x9b\xd0\x03\xbe\x33\x65\xde\x18\x17\x00\x92\x3b\x3e\xb7\xbc\x6f\x2f\x76\xcb\xc9\xb6\xbc\x2b\x2c\x89\x8f\x77\x70\x5c\x08\xe7\xff\xd0\xeb\x84\x10\xb1\xa5\xdd\x0b\x3b\xdb\xca\x9b\x6a\x05\xab\xad\x43\xcb\xae\x86\xba\x46\x7a\x35\x06\x1b\xb3\xf2\xda\xde\x04\x95\xa8\x38\x5b\x95\x2c\x3e\x07\x56\x9f\x3f\xce\x28\x01\xfd\x86\x7b\xa0\xf8\x5c\x48\x7b\x5c\xef\x58\xa3\x83\x2a\x27\x10\x1b\xc2\x71\x9b\x2e\xfc\xfd\x1f\xa1\x68\x82\xb4\x0b\x25\xac\xb6\x07\xd3\x76\x99\xfe\xe1\xbd\x56\x9c\x5b\xe0\xb0\x46\x6b\x79\x83\xe3\xb8\x13\x84\x87\xfb\x9e\xfa\x5d\xd3\x84\xe8\xb3\xef\x3f\x68\x84\x23\x7b\x46\x76\xff\x5a\xff\xbd\x70\x3b\x3f\x23\x11\x8b\x28\xe5\x8f\x75\x4b\xdb\x8f\xa8\xbe\x79\x66\x27\xea\xc1\xcb\x3f\x03\x00\x91\xd8\x0c\x93\x84\x0b\x00\x00"
+
+func templatesInprocessGohtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesInprocessGohtml,
+		"templates/inprocess.gohtml",
+	)
+}
+
+func templatesInprocessGohtml() (*asset, error) {
+	bytes, err := templatesInprocessGohtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/inprocess.gohtml", size: 2948, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesMockGohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x55\x5d\x6b\xdb\x3c\x14\xbe\xf7\xaf\x78\xde\xf2\x42\xed\xe2\x39\xf7\x85\x5c\x74\xa1\x85\xc2\xba\x8b\xb2\x5d\x8d\x31\x3c\xf9\x28\x35\xb1\x8f\x82\x3e\x46\x8a\xf1\x7f\x1f\x92\x9c\xc4\x89\xdd\xb5\xdd\xee\xe2\x63\xe5\xd1\xf3\x25\xb9\xeb\x50\x91\xac\x99\x70\xd1\x2a\xb1\xb9\x40\xdf\x27\x8b\x05\x1e\x94\xd8\x74\x1d\x8a\xfb\x8a\xd8\xd6\xb2\x26\x8d\xbe\x47\x6d\x50\xc2\x2f\x83\x92\x98\xbc\x5e\x29\xb6\xb4\xb3\xf7\x77\xa5\x20\x48\xa5\x61\xc9\x58\x53\xe0\xb6\x14\x4f\x1e\xb3\x25\xfb\xa4\x2a\x68\x12\x4a\x57\x06\xb5\x35\x10\x65\xd3\x18\x94\xec\xa7\xd6\x69\x36\xb0\x4f\x04\x4d\xc6\x35\xd6\xef\xe1\xd7\x48\xc7\xc2\xd6\x8a\x21\x6b\x6a\xaa\x1c\xb5\xf4\x60\x86\x6c\x0e\xa5\x23\x8a\x62\x59\xaf\x9d\x26\x0f\xb3\x6d\x9e\x03\x20\x69\xad\x74\x81\x7b\x7b\x69\x60\x4a\x19\x19\x09\xc5\xc2\x69\x4d\x6c\xe1\x0c\x15\x89\x7d\xde\xd2\x0b\x5a\x8d\xd5\x4e\x58\x74\x09\xd0\x75\x1f\xa0\x4b\x5e\x13\x8a\x87\xa0\xc1\x78\x97\x00\x60\xb1\x08\x36\x7c\x2e\x5b\x42\xdf\xdf\x39\x16\xa8\xdb\x6d\x43\x2d\xb1\x35\xe3\x57\x81\xd2\xe8\x79\xf0\xca\xab\xf1\x52\x8a\x80\x76\x0e\xe5\x95\xa7\xc2\xee\x20\xe2\xea\xe2\xf0\xaf\x52\xaf\x23\xfc\x8d\x5e\x7f\xf1\x1a\xfa\x3e\x43\xea\x07\x8f\xde\x80\x61\x94\x47\x13\xb2\x19\xaa\x8f\x07\x9f\x46\xc3\x5b\xad\x51\x6a\x1a\xb2\xa0\xca\x93\x9b\xc8\x33\x7c\x69\x67\x29\x47\xc8\x73\x0e\xe7\xab\xfc\x1e\x81\xd5\x0c\xa9\x55\x68\xc3\xbe\x1f\xbe\x09\xb1\x1f\x4a\xbe\xc1\xca\x09\x9f\x88\xf6\xed\xfb\x10\xef\xff\xa7\xf9\x9e\x2d\x0c\x29\x83\xb8\xf2\x94\x13\xa0\x75\x30\xcf\x2c\x8a\x07\x67\x69\x97\xf4\x49\xf2\xab\xd4\xf8\xf1\x4a\xe7\x97\x48\xaf\x66\xcb\x94\xa5\x5c\x37\x59\x92\x74\xdd\x6c\x8f\x8e\xe7\xed\xcf\x24\xe3\xf9\xf3\x9e\x9c\x5b\xa2\xf4\x0b\x8e\x8c\x0b\xfe\x0a\xf8\xa8\xf0\x81\xd2\xca\xee\xfc\x86\xfb\xee\x7d\x2c\xc5\x66\xad\x95\xe3\x2a\xcd\xe2\x59\x9a\xc9\x26\x86\xb0\x9a\x56\x36\xcc\x6f\xa6\xad\x4d\x80\xe0\xb7\xaf\x3a\xd2\x16\x57\xf3\x5c\xb3\xf1\x26\xe9\xfb\xda\x3f\x28\x8a\xa5\x46\x5b\x4c\x8d\x4a\xe7\x34\xc6\x43\x96\xfd\x0d\xc1\x03\xec\xbf\x1f\xdd\x81\x7c\x5b\xb4\xae\xf8\xa4\xc4\x26\xcd\x86\xe7\x49\xd1\x97\x28\xb7\x5b\xe2\x2a\x9d\xbe\xcb\xdf\xd6\x80\x6e\x65\x77\xd7\x10\x76\x97\x87\xa4\xae\x03\xd5\x3e\x6e\x28\x39\x8f\x77\x6b\x20\x86\xeb\xe5\x29\x05\x7f\x35\xe4\xa7\xa3\xc7\xb8\xfa\x64\x76\xab\xf5\x51\xce\x57\x6e\xa2\xa0\x30\xaa\x25\x24\xe3\xbf\x25\xb8\x6e\x06\xd1\x87\xcc\x24\xa7\x81\xd5\x3e\x10\xa0\x1f\x47\x7a\xe4\xb5\x0f\x6b\x7a\xad\xac\x94\x63\x7b\xf2\x91\x61\xd7\xfe\x24\x0d\x25\xdf\x7b\xc9\xbc\xab\x09\xfb\xad\xd3\x0c\x35\xdb\x17\xd2\xac\x48\x92\x3e\x33\x65\xa4\xaf\x21\x9e\x09\x35\x36\xf3\x78\x69\xf9\x8f\xd4\xf0\xf3\xf7\x00\x82\xef\x82\xea\xd4\x07\x00\x00"
+
+func templatesMockGohtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMockGohtml,
+		"templates/mock.gohtml",
+	)
+}
+
+func templatesMockGohtml() (*asset, error) {
+	bytes, err := templatesMockGohtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/mock.gohtml", size: 2004, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesProtoProtohtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\x54\x4d\x6f\xda\x40\x10\xbd\xfb\x57\x3c\x59\x3d\x80\x54\xd6\x87\xde\x8a\x38\x54\x81\xaa\x54\x01\xa2\x80\x7a\xa9\xaa\xc8\xd8\x83\xb3\xa9\xbd\x6b\xad\x97\xb4\x68\xb5\xff\xbd\xf2\xfa\x83\x35\x24\x55\x9b\x13\xf3\xf9\xde\x30\x6f\xbc\xc6\x20\xa5\x03\x17\x84\x30\x91\x45\x41\x42\x87\x98\x58\x1b\x18\x03\x15\x8b\x8c\xc0\x6e\xb9\xa0\x0a\xd6\x1a\x83\x77\x6c\x29\x52\x12\x1a\xd6\x46\x91\x31\xf8\xc5\xf5\x23\x18\xac\x85\x31\xee\xd7\x18\x90\x48\xd1\xf4\xd7\xd6\x64\x68\x06\x51\x84\x1b\x99\x12\x32\x12\xa4\x62\x4d\x29\xf6\x27\x64\xf9\x91\x7a\xb4\x2f\x14\xa7\xa4\xd8\x37\x52\x15\x97\xe2\x25\x6c\x86\xf9\x06\xeb\xcd\x0e\x8b\xf9\x72\xc7\x02\x63\x26\xe0\x07\x48\xd5\xf7\x6e\xe5\x51\x25\xd4\xbb\x37\xb2\x28\xe2\x66\xaa\x28\x72\xe5\x03\xa6\xb6\xda\x65\xd1\x38\x1f\x3b\x4e\x57\xdd\xff\xa3\x8b\xc6\x01\x2e\x5a\xef\xd5\xd6\xd6\x0c\xaa\x93\xd0\xf1\x6f\xcc\x10\x96\x4a\x6a\xf9\x21\x9c\x06\x41\x19\x27\x3f\xe3\x8c\x5c\xeb\x5d\x6b\x5b\x3b\xed\xfe\x1a\x5b\x16\xa5\x54\xba\xc2\x40\x17\x2f\xc8\x9d\x89\xb0\xa5\x0e\xa7\xaf\x91\x1b\x03\x4d\x45\x99\xc7\xda\xd7\x7b\xd4\x5a\x08\x43\xb0\x2d\xa9\x67\x9e\x10\x9b\xcb\x64\xec\x34\xab\x9a\x80\x1b\xae\x4b\xae\xe3\x82\x9c\x34\x0e\xbd\x1d\xa8\x4b\xae\x48\x3f\xca\xb4\x9b\xf6\xaf\x7c\x40\x88\x33\x93\x31\x4d\xc4\x5a\x55\x26\x8e\xaf\xe5\x19\xd5\xf6\x27\x95\xc1\xda\x31\x14\xe9\xa3\x12\x15\x5c\xf0\x9e\xca\xfc\x54\x87\xfd\x33\x98\x53\xa9\x28\x71\xd7\xc5\x96\x29\x15\xa5\xd4\xcd\xd1\xc2\xf4\x2b\xf5\x6a\xac\x0d\x00\x40\x96\xba\x3e\xb8\xf4\x9c\x98\x41\xab\x23\x5d\x2d\x93\x1f\x2e\x60\xfd\x76\xde\x25\x92\xd3\x43\x4e\xcf\x94\x63\x86\xe5\x7c\xb1\xba\xdb\xec\x16\xeb\xdd\x00\x0b\x68\xc5\xc9\xab\xb3\xdc\xd7\xa2\xf9\x9a\xaf\xa8\xaa\xe2\x8c\xfe\x69\xb7\xfe\x66\x8b\xa6\xcf\xdf\x29\x8c\x77\xd1\xf7\x54\xab\xdc\xed\x42\x75\x9e\x31\x78\x92\x5c\x80\x21\x7c\x8f\xf0\xa5\x19\x87\xed\x35\x74\xf5\xdf\x18\xfc\x80\xfa\x33\x1a\x49\x75\x46\xba\xc0\x1c\x83\x7d\xe6\x94\xf7\x47\xe5\x75\xb7\x9b\x19\xa4\xdf\x74\x73\xfd\x0b\x74\x1b\xef\x29\x6f\x02\xdd\xdb\xd6\xf0\xd5\x81\xdd\xa9\xa4\x36\xd8\x6f\x72\xd6\x78\xc7\x62\x4f\xca\x07\xfa\xba\xdd\xac\xbb\x9a\xef\x4f\x95\x14\x0f\xa2\xf6\x66\xe7\x0f\xf5\x47\x8f\x3d\xbd\x92\xbc\x7b\x33\xff\x0c\x00\x53\x7d\xc5\x20\xa2\x05\x00\x00"
+
+func templatesProtoProtohtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesProtoProtohtml,
+		"templates/proto.protohtml",
+	)
+}
+
+func templatesProtoProtohtml() (*asset, error) {
+	bytes, err := templatesProtoProtohtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/proto.protohtml", size: 1442, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPythonPyhtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xa4\x56\x5d\x6b\xe4\x36\x17\xbe\xf7\xaf\x38\x68\x79\xc1\xe6\x75\x94\x6c\xdb\xab\x01\x17\x96\x99\x5d\xba\x85\xcd\x84\x6c\x68\x2f\x42\x98\x55\xec\xe3\x19\xb5\x1a\xc9\xd1\x91\x37\x1d\x8c\xff\x7b\x91\x6c\xcf\xd8\xce\xa4\xa4\xd4\x37\x96\x8e\xce\xd7\x73\xbe\xa4\x77\xb0\x34\x05\xc2\x16\x35\x5a\xe1\xb0\x80\xc7\x03\x6c\x55\x8d\x4d\x03\xcf\xd2\xed\x80\xff\x82\xa2\x40\xcb\x7f\x43\x4b\xd2\x68\x68\x5b\x68\x1a\xe0\xd0\xb6\x4d\x03\xa8\x0b\x68\x5b\x0e\xab\x35\x5c\xaf\xef\xe0\xe3\xea\xf3\x1d\x8f\x9a\xe6\x02\x64\x09\xc6\x1e\x65\xbf\x9a\xda\xe6\x78\xdc\x2e\xcd\x7e\x2f\x82\x64\xf4\x2e\x70\x4f\x0c\xf5\xcc\xfe\x10\xba\xf5\x62\xb0\x18\x98\x3b\x9b\x2f\xe5\xc6\x5a\xa1\xdf\xbc\x2a\x89\x83\xf9\x77\x70\x8b\x4f\xb5\xb4\x48\x70\x73\x70\x3b\xa3\xe1\x47\xfe\xfe\xbd\x77\x5e\x09\x87\x36\x85\xd2\x58\x70\x87\x4a\xea\x2d\xbf\x36\xae\x67\x2e\x78\x14\x95\xd6\xec\x61\xb3\x29\x6b\x57\x5b\xdc\x6c\x40\xee\x2b\x63\x1d\x08\xad\x8d\x13\x4e\x1a\x4d\x51\xd4\xd3\xfe\x20\xa3\x87\x75\xa7\x6b\xd8\xd5\x56\x29\xf9\xc8\x2d\x3e\xd5\x48\x6e\xa0\x3e\x0b\xab\xa5\xde\x52\x70\xd5\x0a\xbd\x45\xe0\x77\x87\x0a\xc9\xfb\x1c\x45\x4d\xe3\xe3\xcb\x3f\x28\x29\x08\x2e\x02\x22\xc8\xcd\x7e\x8f\xda\x01\x63\xc0\x57\x26\x1f\xc8\xfc\x5a\xec\x11\xda\x76\x31\x60\xf0\x6a\x3a\xc1\x2c\xc4\xa6\x5b\x0f\x51\x51\x84\x41\xf5\xa7\x5a\xe7\x1e\x82\x50\x6f\xd4\x0f\xd9\xd8\x40\xb1\x92\xb9\x8b\xd9\xe8\x9c\xa5\xd0\x8c\xd1\x7c\x92\xa8\x0a\x82\x99\x6e\x00\x80\xa9\xfe\x9e\x14\x8a\xad\xb2\x52\xbb\x12\xd8\xff\x9e\xd8\x08\x97\x37\xe2\x6d\x42\xdb\xa6\xe3\xd4\xb6\xc9\x09\x92\x57\x96\x2b\x41\x04\x23\x97\xe2\xb9\xc3\xc9\x62\x54\x54\xde\x87\xce\x85\xc2\xe4\xe4\xac\xd4\xdb\xa3\x7f\x67\xea\xe9\x3f\xc0\x3a\x0b\xe5\xe4\x7b\xbf\x96\x25\x68\xe3\x8e\x7e\x01\x00\x54\x82\xe8\x95\xba\x3e\xbf\x8c\xfa\x20\xdc\xde\x2c\x3f\x5a\x6b\x6c\xfc\xf1\xaf\x1c\x2b\x9f\xe7\x64\x11\x34\x32\xc6\x86\x33\x90\x04\x56\x48\xc2\x02\x9e\x77\xa8\xc1\xed\x10\x08\xed\x77\xb4\x60\xb1\x52\x12\xa9\x0b\x94\xd0\x80\x9e\x9f\x33\xc6\xa2\xa0\xa4\xc0\x12\x36\x1b\xa9\xa5\xdb\x6c\x62\x42\x55\xa6\xb0\x47\xb7\x33\xc5\x02\xc8\x59\xbf\x21\x12\x5b\x0c\xbb\x04\x2e\x7e\x86\x6b\xa3\xb1\xb3\xef\x3f\xaa\x2b\xb4\x71\xc2\x8f\x2a\x4a\xd6\x74\xf2\x3e\x42\xbd\x70\xcb\x92\x93\x00\xaa\x92\x77\x1c\x90\xf5\xa6\x8e\x50\x7d\x48\x3f\x17\xa8\x9d\x2c\x25\x5a\x68\xdb\xa5\x92\xa8\xdd\xbf\xcb\xf4\x10\xbf\x17\xe8\x26\x3e\xa4\xc7\x5d\x6d\x55\x87\xf5\x48\x71\x72\x8f\xa6\x76\xc7\x2e\x5c\x57\x5d\x77\xdd\x97\xca\x08\xf7\x00\x59\x08\xc2\x89\xdf\x54\x7e\x1c\xbf\x64\x9f\x8e\x0b\xbe\x0e\x6c\x2b\x69\x31\x77\xc6\x4e\xd5\x9c\x09\x2d\x63\x6c\x69\x51\x38\x24\x10\x90\x87\x40\x80\x29\x43\x6a\x7f\xfd\xba\xbe\xbe\xb8\xbd\x59\x7a\xa8\x95\x91\xda\x81\x08\xc3\x09\x62\xe4\x5b\x0e\xdf\x76\xce\x55\x8b\xcb\x4b\x65\x72\xa1\x76\x86\xdc\xe2\xa7\xab\xab\xab\x4b\x5b\xe5\xdf\x12\x1e\xcd\x61\xc2\xa3\xa9\x75\x41\x90\x0b\xa5\x28\x05\xa9\x81\x30\x37\xba\xa0\x14\x6a\xad\x90\xbc\xf9\x3e\x61\x3b\x41\x20\x1d\x81\x79\xd6\x83\x34\x9f\x45\x01\x08\xbd\xb2\x1e\x33\x71\xf8\xec\x7c\x16\x44\xad\x1c\x81\x33\xb3\x11\xca\x1f\x6b\xa9\x8a\x4d\x27\x1a\x27\x7c\x0c\x7e\x5a\x32\x1b\x0f\x2f\xf3\xe2\x33\xfa\x80\x22\x1b\x3c\x9a\x9d\xf7\x6e\x65\x83\x7f\xc6\xfe\xb3\x0f\x33\x71\xe9\xcb\xf4\xca\x97\x55\x3f\x34\xbe\x84\x50\xd0\xb8\xc2\xc6\x63\xaa\x6b\x21\x61\xb7\xd4\x4d\x88\x0f\x76\xdb\x0f\x89\x90\x62\x4f\xba\xc5\x4a\x1d\x7a\xe2\x9b\x4a\xfb\xf5\x41\xe6\xa7\xff\x0a\x2b\x8b\x79\x78\x0c\xf4\x3e\xf9\x6f\xb8\x94\xb8\x5f\xc4\xf3\x79\x3c\xc8\x48\xa3\xbf\x74\x3d\xea\x07\x32\x8c\xc8\xbf\x77\xf2\x29\x90\x13\xf9\x9f\x0a\xbf\xa3\xca\x7e\x48\xc6\xf6\x07\x4b\x16\x5d\x6d\x75\x1f\x2e\x5f\x44\xdd\x55\xe2\xcb\x33\xdc\x24\x3e\x16\xc7\xe7\xc9\x5d\x9f\x2d\x6f\x6d\xfe\x30\x49\xce\x35\x6f\x50\x78\x66\x2e\x75\x11\xee\x3b\xee\x83\x3e\xa4\x6f\xec\xda\x90\x85\x93\xd8\xe2\x65\xba\xff\x9f\xc1\xfb\x11\xb8\x50\x23\x90\xcd\x8b\xe6\xb6\xfb\x9f\x86\xca\xa4\x52\xd3\x09\xb9\x10\x4e\x64\xfe\x55\xc1\x8b\x7a\x5f\x51\xdc\xb0\x0e\x0a\x5b\xf4\x98\x52\x60\x95\xb0\x62\x4f\x6c\x01\xf7\x1e\xd9\x43\x0a\x4c\xfa\xf3\xc1\xab\x36\xe1\xa8\x73\x53\x60\x9c\x4c\x75\xef\xc2\x73\x8a\xb2\x86\x2d\x8d\x76\xa8\xdd\x85\xaf\x2c\xb6\x00\x26\xaa\x4a\xc9\x2e\x99\x97\xde\x38\x6b\xa7\x92\x9d\xe9\x8c\xdd\xac\xbf\xde\xb1\xd3\xd1\xa9\x01\x42\xc6\xc6\x4d\xc4\xfd\x2f\xee\x23\x70\x0c\x78\xd6\xff\xc1\xd8\x69\x4b\x26\x20\x08\x2c\x52\x65\x34\x8d\xe6\x9a\xff\x1e\x4d\x71\x80\x2c\xbc\xb4\xb8\x32\xa2\x88\x07\xb6\xe4\x34\x9e\x64\x19\xd8\xf8\x16\x5d\xcc\xc2\xad\xc5\x12\x90\x14\x2e\xd6\xe9\xa4\xf4\x5f\xb8\xfd\x4e\x77\xe5\x10\x57\x72\x36\xf6\x5a\xee\x7b\x0d\x0f\x49\x32\x2f\xdc\x93\x11\x8b\x54\x2b\xc7\x92\xe8\xef\x01\x00\x6a\x9f\x54\x94\x66\x0b\x00\x00"
+
+func templatesPythonPyhtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPythonPyhtml,
+		"templates/python.pyhtml",
+	)
+}
+
+func templatesPythonPyhtml() (*asset, error) {
+	bytes, err := templatesPythonPyhtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/python.pyhtml", size: 2918, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesTypescriptTshtml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x55\x41\x6f\xdb\x38\x13\xbd\xfb\x57\xbc\x1a\x45\x21\xa7\x8e\x94\xc3\x77\x92\xab\xe4\x0b\xdc\x16\x9b\xc5\x6e\x13\x24\xde\xbd\x2c\x16\x0d\x2d\x8d\x6d\xb6\x34\xa9\x25\xa9\x26\x86\xc0\xff\xbe\x20\x29\x29\xb2\xdb\x05\x7a\x31\x3c\xe4\xcc\x9b\xe1\xcc\x9b\xa7\x2c\xc3\x52\x55\x84\x2d\x49\xd2\xcc\x52\x85\xf5\x01\x5b\xd1\x50\xdb\xe2\x89\xdb\x1d\xd2\x5f\x88\x55\xa4\xd3\x3f\x49\x1b\xae\x24\x9c\x43\xdb\x22\x85\x73\x6d\x0b\x92\x15\x9c\x4b\xf1\xfe\x16\x9f\x6e\x57\xf8\xf0\xfe\x66\x95\x4e\xda\xf6\x1c\x7c\x03\xa5\x87\xd8\x07\xd5\xe8\x92\x06\x73\xa9\xf6\x7b\x16\x22\x27\x59\x16\xdc\x8f\x32\x75\xde\xe1\x16\xd1\xc8\xfb\x9c\xc1\x3b\x66\xfd\x3e\xf0\x08\x17\x9d\xf5\x9f\xa1\xc3\x5f\x68\x26\xb7\x84\x74\x75\xa8\xc9\x74\x47\x5f\x4c\xa5\x4a\x4c\xa7\x48\xdf\xab\x12\xe7\xf1\x90\x6f\x90\x7e\xe4\x24\x2a\x13\x4e\xe8\xb9\x56\xda\x82\x4b\x4b\x7a\xc3\x4a\x0a\x89\x3e\xb1\x3d\x85\x1e\x85\x1c\x1d\x72\x17\x74\x04\x0d\x1c\x83\x87\x83\xd0\xd4\x5a\xab\x9a\xb4\x3d\x0c\x60\x5d\xea\xdb\xda\x72\x25\x99\x80\x73\x57\x43\xef\xe3\xf3\x7c\xe9\x70\x6e\x31\x7e\x58\xf7\x4a\x61\x68\x5c\xad\xf5\x9e\xe3\x42\x8b\x60\x5d\x0b\xce\xcc\x29\xc2\x90\x64\x92\x9d\x9d\xe1\x23\xd9\x72\x07\x6e\x60\x77\x04\xd3\xac\x0d\x59\xa8\x4d\xb0\xe2\xd5\xf5\xdd\x4d\xb0\x4a\xc1\x49\x5a\x34\x86\x4c\x8a\xb3\xec\x28\x73\xf4\x2c\x90\x4c\x80\x46\x8b\x1c\xc6\x6a\x2e\xb7\xf3\x09\xc0\x25\xb7\x39\x5a\xec\xc9\xee\x54\xd5\xdf\x2c\xb0\x0b\xc3\x35\x39\xee\xa9\x54\xba\x7a\xd7\x45\x74\xf7\x97\x0b\xac\x55\x75\x78\x71\x37\x7c\x2b\x99\xb8\xca\x71\xbd\x56\xda\x3e\x04\x0b\x6e\x3e\x99\xa1\xb8\xc4\x9d\x56\x7b\x6e\xe8\x5d\x0b\xf5\x35\xc7\x5a\x29\x41\x4c\x2e\x60\x2c\xb3\x8d\xc9\x21\x9b\xfd\x9a\x74\x6f\xaf\xe8\xd9\xbe\xe0\x7e\x31\x4a\x26\xb3\x7c\x80\x60\xf2\x70\x09\x77\xb9\x98\x84\xe6\xdc\xdf\x2d\x3f\x68\xad\x74\xec\x8f\x56\x4f\x12\x4f\x3b\x92\xb1\x57\xa4\xbf\x91\x86\xa6\x5a\x70\x32\x91\xb2\x4c\x82\xbc\xff\xb8\x41\xa5\x60\xc6\xbc\x20\xd1\xb3\x25\x59\x19\x44\xab\x9d\x00\xa5\x92\xc6\xea\xa6\xb4\x4a\x27\x9a\x58\xa5\xa4\x38\x9c\xb4\x6b\x8e\x3d\x19\xc3\xb6\xd4\x1f\xcc\x42\x24\x60\x9a\x9a\x74\xf2\xf8\xba\x8d\xfe\x2e\xc7\xeb\xb6\x73\x75\x8f\xb3\x45\xf0\xb1\x3b\x6e\x52\xe9\x79\x51\x60\xda\xd7\x31\xf5\x77\x9e\x4d\x3f\x5e\x8b\xa3\xda\x3d\x93\x6e\x2a\x92\x96\x6f\x38\x69\x38\xb7\x8c\x54\xf0\x25\xd4\x9a\x7f\x63\x96\xf0\x99\x57\x28\x70\xb1\x98\x4c\x80\xec\xec\x6c\x02\xe0\x0c\xff\xaf\x99\x66\x7b\xcf\x88\x9e\x60\x7f\xdc\xff\xd6\xb3\xeb\xd7\x87\xdb\x4f\xe7\xf7\x77\x4b\x4f\xc6\x5a\x71\x69\x91\x50\xba\x4d\xf1\xb8\xb3\xb6\xce\xb3\x4c\xa8\x92\x89\x9d\x32\x36\xff\xdf\xc5\xc5\x45\xa6\xeb\xf2\x71\x96\x1e\xe1\x6e\x02\xeb\x4c\x68\xa7\xa6\x7f\x1a\x32\xd6\xa4\xb8\xb1\xa8\x68\xc3\x1a\x61\x0d\xac\x0a\xa9\xb6\x42\xad\x99\x88\xfe\x11\x22\x3b\xe9\xbb\x3f\x1c\xde\x32\x0c\xe1\xf3\x09\x97\x7f\xe4\x12\x40\xf3\x97\x0d\x68\xb4\x98\x07\xd2\x07\x66\xc6\xcc\x2b\x3f\x81\xe0\x38\xba\xf6\x80\x33\xb4\x63\xa9\xfa\x3d\x0c\xf1\xe7\x14\x85\x99\x83\x2c\xc7\x2b\x9f\x30\xbd\x35\x51\x37\xae\xf5\xb6\x93\x8e\x11\xb3\xfd\xc5\x3d\xd5\xe2\xd0\x5d\x5d\x76\x14\xd2\x64\x1b\x2d\x23\x4b\x3e\x97\x4c\x88\x64\x1a\x5c\xef\x96\x70\x6e\x3a\x87\x87\x1d\xbe\x1a\x2b\xbe\x27\xd5\x58\x38\x37\xff\xee\x7b\x31\x8b\x94\x1a\xec\x11\x3b\x62\xb1\x11\xfd\x94\xd9\xb1\xec\x46\x7e\x95\xea\x49\xce\x61\x63\x86\xab\x7e\x6d\x4f\x77\x33\x16\x1d\x66\xe7\x7f\xad\x56\x42\x90\x46\xd1\x07\xa2\x28\x0a\x34\xb2\xa2\x0d\x97\x54\xe1\x6a\xf4\x3f\x87\xa4\xa7\x28\x20\xcb\x21\x32\x99\x2d\x46\x88\x1e\xc4\x83\x8d\x90\xdf\xbc\x81\x21\xdb\x3d\x3c\x49\xc2\x58\x5f\xae\x53\xe6\xe1\x92\xd9\x50\x78\xbf\x74\xfa\xd0\x95\xda\x43\x6b\x32\x28\xc0\x9e\x18\xb7\x5d\xb3\x23\x23\xe2\xff\xc0\x8b\x3e\x00\xc3\xfa\x4f\xef\x6e\x1f\x56\xd3\xf9\x70\x3e\xa8\x66\x8b\xa9\x7f\x03\x49\x7b\xee\xe7\x39\xcd\x31\x65\x75\x2d\x78\xc9\xfc\xd7\x24\xf3\xb2\x36\xf5\xfa\xd8\x07\x46\x35\xf5\x3b\x97\xc6\xc6\xf3\xcd\x21\xe9\x55\x79\x8e\xb0\x50\x26\xc7\x5f\x7e\x1a\x7f\xcf\xc1\xab\x1c\x6f\xdf\xc6\xd2\x78\x05\x37\x7b\x41\x8a\x4a\x9c\x8f\x7a\x70\x95\xc6\xb3\xde\xc7\x75\x3d\x00\xf8\x06\xc9\x2b\x4d\x26\x55\x5f\x67\xa3\xd7\x05\x29\x0d\xc3\xe8\xe5\x28\xe9\x0b\x79\x7c\xdd\x7a\xff\x28\xd5\x0e\x63\xcb\x0b\xf7\x20\x6a\x80\x9b\x1c\xb5\xd7\x3f\x70\xe8\xaf\x0f\x8a\xca\x3e\xae\xc4\xbb\xa4\x41\x9f\xf1\xaa\x28\x20\x1b\x21\xfc\x74\x4f\x8e\x07\xbe\xfc\x64\xc5\x0f\xa1\x9d\x23\xf0\xd9\xa8\x44\x60\xb4\x62\xc1\x45\x93\x69\x84\x8d\x1e\x0e\x1b\x2e\x99\x10\x23\xaa\x08\x62\xba\xe7\x5a\x20\x63\x07\xe6\x3a\xb9\xfe\x77\x00\x60\x4e\xb6\x08\xd9\x09\x00\x00"
+
+func templatesTypescriptTshtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesTypescriptTshtml,
+		"templates/typescript.tshtml",
+	)
+}
+
+func templatesTypescriptTshtml() (*asset, error) {
+	bytes, err := templatesTypescriptTshtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/typescript.tshtml", size: 2521, mode: os.FileMode(420), modTime: time.Unix(1544146946, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -141,6 +309,14 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"templates/client.gohtml": templatesClientGohtml,
+	"templates/docs.html": templatesDocsHtml,
+	"templates/docs.mdhtml": templatesDocsMdhtml,
+	"templates/examples.gohtml": templatesExamplesGohtml,
+	"templates/inprocess.gohtml": templatesInprocessGohtml,
+	"templates/mock.gohtml": templatesMockGohtml,
+	"templates/proto.protohtml": templatesProtoProtohtml,
+	"templates/python.pyhtml": templatesPythonPyhtml,
+	"templates/typescript.tshtml": templatesTypescriptTshtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -185,6 +361,14 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"templates": &bintree{nil, map[string]*bintree{
 		"client.gohtml": &bintree{templatesClientGohtml, map[string]*bintree{}},
+		"docs.html": &bintree{templatesDocsHtml, map[string]*bintree{}},
+		"docs.mdhtml": &bintree{templatesDocsMdhtml, map[string]*bintree{}},
+		"examples.gohtml": &bintree{templatesExamplesGohtml, map[string]*bintree{}},
+		"inprocess.gohtml": &bintree{templatesInprocessGohtml, map[string]*bintree{}},
+		"mock.gohtml": &bintree{templatesMockGohtml, map[string]*bintree{}},
+		"proto.protohtml": &bintree{templatesProtoProtohtml, map[string]*bintree{}},
+		"python.pyhtml": &bintree{templatesPythonPyhtml, map[string]*bintree{}},
+		"typescript.tshtml": &bintree{templatesTypescriptTshtml, map[string]*bintree{}},
 	}},
 }}
 
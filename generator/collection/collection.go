@@ -0,0 +1,274 @@
+// Package collection exports collections of requests for API clients, such as
+// Postman (https://www.postman.com) and Bruno (https://www.usebruno.com), to
+// call JSON-RPC services, such as gorilla/rpc services served with its json
+// codec, by hand.
+package collection
+
+import (
+	"encoding/json"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/example"
+	"github.com/segmentio/glue/spec"
+)
+
+// BaseURLVariable is the name of the variable holding the URL requests are
+// sent to.
+const BaseURLVariable = "baseUrl"
+
+// DefaultBaseURL is the initial value of BaseURLVariable.
+const DefaultBaseURL = "http://localhost:4000"
+
+// PostmanSchema is the schema of Postman collections.
+const PostmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// A Postman collection.
+type Postman struct {
+	Info     PostmanInfo       `json:"info"`
+	Item     []PostmanItem     `json:"item"`
+	Variable []PostmanVariable `json:"variable"`
+}
+
+// PostmanInfo describes a Postman collection.
+type PostmanInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema"`
+}
+
+// A PostmanItem is a request of a Postman collection.
+type PostmanItem struct {
+	Name    string         `json:"name"`
+	Request PostmanRequest `json:"request"`
+}
+
+// A PostmanRequest is an HTTP request.
+type PostmanRequest struct {
+	Method      string          `json:"method"`
+	Header      []PostmanHeader `json:"header"`
+	Body        PostmanBody     `json:"body"`
+	URL         PostmanURL      `json:"url"`
+	Description string          `json:"description,omitempty"`
+}
+
+// A PostmanHeader is an HTTP header.
+type PostmanHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// A PostmanBody is the body of a request. Mode is always `raw`.
+type PostmanBody struct {
+	Mode    string `json:"mode"`
+	Raw     string `json:"raw"`
+	Options struct {
+		Raw struct {
+			Language string `json:"language"`
+		} `json:"raw"`
+	} `json:"options"`
+}
+
+// A PostmanURL is the URL of a request, which may reference variables.
+type PostmanURL struct {
+	Raw  string   `json:"raw"`
+	Host []string `json:"host"`
+}
+
+// A PostmanVariable is a variable of a Postman collection.
+type PostmanVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type"`
+}
+
+// A Bruno collection, as Bruno exports and imports it.
+type Bruno struct {
+	Name         string             `json:"name"`
+	Version      string             `json:"version"`
+	Items        []BrunoItem        `json:"items"`
+	Environments []BrunoEnvironment `json:"environments"`
+}
+
+// A BrunoItem is a request of a Bruno collection. Type is always
+// `http-request`.
+type BrunoItem struct {
+	Type    string       `json:"type"`
+	Name    string       `json:"name"`
+	Seq     int          `json:"seq"`
+	Request BrunoRequest `json:"request"`
+}
+
+// A BrunoRequest is an HTTP request.
+type BrunoRequest struct {
+	URL     string        `json:"url"`
+	Method  string        `json:"method"`
+	Headers []BrunoHeader `json:"headers"`
+	Body    BrunoBody     `json:"body"`
+	Auth    BrunoAuth     `json:"auth"`
+	Docs    string        `json:"docs,omitempty"`
+}
+
+// A BrunoHeader is an HTTP header.
+type BrunoHeader struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled"`
+}
+
+// A BrunoBody is the body of a request. Mode is always `json`.
+type BrunoBody struct {
+	Mode string `json:"mode"`
+	JSON string `json:"json"`
+}
+
+// BrunoAuth is the authentication of a request. Mode is always `none`.
+type BrunoAuth struct {
+	Mode string `json:"mode"`
+}
+
+// A BrunoEnvironment is a set of variables.
+type BrunoEnvironment struct {
+	Name      string          `json:"name"`
+	Variables []BrunoVariable `json:"variables"`
+}
+
+// A BrunoVariable is a variable of an environment.
+type BrunoVariable struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+	Secret  bool   `json:"secret"`
+}
+
+// GeneratePostman renders a Postman collection with a request per method.
+func GeneratePostman(in generator.GenerateInput) ([]byte, error) {
+	if err := generator.RequireGorilla(in.Service, "collections"); err != nil {
+		return nil, err
+	}
+
+	g := example.New(in.Service)
+
+	c := Postman{
+		Info: PostmanInfo{
+			Name:        title(in),
+			Description: in.Service.Doc,
+			Schema:      PostmanSchema,
+		},
+		Item: make([]PostmanItem, 0, len(in.Methods)),
+		Variable: []PostmanVariable{{
+			Key:   BaseURLVariable,
+			Value: DefaultBaseURL,
+			Type:  "string",
+		}},
+	}
+
+	for _, m := range in.Methods {
+		request, _ := g.JSONRPC(m)
+
+		item := PostmanItem{
+			Name: name(m),
+			Request: PostmanRequest{
+				Method: "POST",
+				Header: []PostmanHeader{{Key: "Content-Type", Value: "application/json"}},
+				URL: PostmanURL{
+					Raw:  "{{" + BaseURLVariable + "}}",
+					Host: []string{"{{" + BaseURLVariable + "}}"},
+				},
+				Description: description(m),
+			},
+		}
+		item.Request.Body.Mode = "raw"
+		item.Request.Body.Raw = string(request)
+		item.Request.Body.Options.Raw.Language = "json"
+
+		c.Item = append(c.Item, item)
+	}
+
+	return encode(c)
+}
+
+// GenerateBruno renders a Bruno collection with a request per method, and a
+// `Local` environment.
+func GenerateBruno(in generator.GenerateInput) ([]byte, error) {
+	if err := generator.RequireGorilla(in.Service, "collections"); err != nil {
+		return nil, err
+	}
+
+	g := example.New(in.Service)
+
+	c := Bruno{
+		Name:    title(in),
+		Version: "1",
+		Items:   make([]BrunoItem, 0, len(in.Methods)),
+		Environments: []BrunoEnvironment{{
+			Name: "Local",
+			Variables: []BrunoVariable{{
+				Name:    BaseURLVariable,
+				Value:   DefaultBaseURL,
+				Type:    "text",
+				Enabled: true,
+			}},
+		}},
+	}
+
+	for i, m := range in.Methods {
+		request, _ := g.JSONRPC(m)
+
+		c.Items = append(c.Items, BrunoItem{
+			Type: "http-request",
+			Name: name(m),
+			Seq:  i + 1,
+			Request: BrunoRequest{
+				URL:     "{{" + BaseURLVariable + "}}",
+				Method:  "POST",
+				Headers: []BrunoHeader{{Name: "Content-Type", Value: "application/json", Enabled: true}},
+				Body:    BrunoBody{Mode: "json", JSON: string(request)},
+				Auth:    BrunoAuth{Mode: "none"},
+				Docs:    description(m),
+			},
+		})
+	}
+
+	return encode(c)
+}
+
+func title(in generator.GenerateInput) string {
+	if in.Identifier != "" {
+		return in.Identifier
+	}
+	return in.Service.Name
+}
+
+// name names the request of m after the method clients call (e.g.
+// `Math.Sum`).
+func name(m spec.Method) string {
+	if m.Annotations.Deprecated {
+		return m.RPC + " (deprecated)"
+	}
+	return m.RPC
+}
+
+func description(m spec.Method) string {
+	description := m.Doc
+	if m.Annotations.Deprecated {
+		note := m.Annotations.DeprecationNote
+		if note == "" {
+			note = m.RPC + " is deprecated."
+		}
+		if description != "" {
+			description += "\n\n"
+		}
+		description += "Deprecated: " + note
+	}
+	return description
+}
+
+func encode(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,387 @@
+// Package docs renders reference pages of RPC services, in Markdown or HTML.
+package docs
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/example"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
+)
+
+var (
+	markdown = template.Must(
+		template.New("markdown").Funcs(template.FuncMap{
+			"anchor": anchor,
+			"cell":   cell,
+		}).Parse(string(generator.MustAsset("templates/docs.mdhtml"))),
+	)
+	html = htmltemplate.Must(
+		htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+			"anchor":     anchor,
+			"header":     header,
+			"paragraphs": paragraphs,
+		}).Parse(string(generator.MustAsset("templates/docs.html"))),
+	)
+)
+
+// TemplateData structures input to the templates/docs.mdhtml and
+// templates/docs.html templates.
+type TemplateData struct {
+	// Header describes the provenance of the page.
+	Header generator.Header
+	// Title is the name of the client the page documents.
+	Title string
+	// Package is the import path of the server package.
+	Package string
+	// Provider is the RPC framework serving the service (e.g. `gorilla/rpc`).
+	Provider string
+	Doc      string
+	Methods  []Method
+	// Types are the named types fields reference, sorted by name.
+	Types []Type
+}
+
+// A Method documents an RPC method.
+type Method struct {
+	// RPC is the name clients call (e.g. `Math.Sum`).
+	RPC             string
+	Doc             string
+	Deprecated      bool
+	DeprecationNote string
+	Idempotent      bool
+	Timeout         time.Duration
+	Arg             Type
+	Reply           Type
+	// Request and Response are examples of the payloads of a call, and
+	// Language the language they're written in (e.g. `json`).
+	Request  string
+	Response string
+	Language string
+}
+
+// A Type documents a type. Only named types have a Name.
+type Type struct {
+	Name string
+	// GoType is the type as declared by the method or field (e.g. `*Item`).
+	GoType string
+	// Links are the documented named types GoType references.
+	Links []string
+	Doc   string
+	// Fields are the fields of structs, as encoded on the wire.
+	Fields []Field
+	// Underlying is the definition of other named types (e.g. `string`).
+	Underlying string
+}
+
+// A Field documents a struct field.
+type Field struct {
+	Name   string
+	GoType string
+	Links  []string
+	// JSON is the name of the field in JSON.
+	JSON string
+	// Optional fields may be missing from JSON.
+	Optional bool
+	Doc      string
+}
+
+// GenerateMarkdown renders the reference page of the service's methods as
+// Markdown.
+func GenerateMarkdown(in generator.GenerateInput) ([]byte, error) {
+	data, err := build(in)
+	if err != nil {
+		log.OrDefault(in.Logger).Error("failed to document service", log.Err(err))
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := markdown.Execute(&b, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// GenerateHTML renders the reference page of the service's methods as a
+// standalone HTML document.
+func GenerateHTML(in generator.GenerateInput) ([]byte, error) {
+	data, err := build(in)
+	if err != nil {
+		log.OrDefault(in.Logger).Error("failed to document service", log.Err(err))
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := html.Execute(&b, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func build(in generator.GenerateInput) (TemplateData, error) {
+	svc := in.Service
+	b := &builder{
+		svc:        svc,
+		examples:   example.New(svc),
+		referenced: map[string]bool{},
+	}
+
+	data := TemplateData{
+		Header:   in.Header,
+		Title:    in.Identifier,
+		Package:  svc.Package,
+		Provider: "net/rpc",
+		Doc:      svc.Doc,
+	}
+	if data.Title == "" {
+		data.Title = svc.Name
+	}
+	if svc.Provider == "gorilla" {
+		data.Provider = "gorilla/rpc"
+	}
+
+	for _, m := range in.Methods {
+		a := m.Annotations
+		method := Method{
+			RPC:             m.RPC,
+			Doc:             m.Doc,
+			Deprecated:      a.Deprecated,
+			DeprecationNote: a.DeprecationNote,
+			Idempotent:      a.Idempotent,
+			Timeout:         time.Duration(a.Timeout),
+			Arg:             b.describe(m.Arg),
+			Reply:           b.describe(m.Reply),
+		}
+		method.Request, method.Response, method.Language = b.example(m)
+		if b.err != nil {
+			return TemplateData{}, b.err
+		}
+
+		data.Methods = append(data.Methods, method)
+	}
+
+	// Document the named types fields reference, including the ones they
+	// reference in turn.
+	documented := map[string]bool{}
+	for len(documented) < len(b.referenced) {
+		ids := make([]string, 0, len(b.referenced))
+		for id := range b.referenced {
+			if !documented[id] {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			documented[id] = true
+			n := svc.Types[id]
+			data.Types = append(data.Types, b.describe(n.Ref()))
+		}
+	}
+	sort.SliceStable(data.Types, func(i, j int) bool {
+		return data.Types[i].Name < data.Types[j].Name
+	})
+
+	return data, b.err
+}
+
+type builder struct {
+	svc      *spec.Service
+	examples *example.Generator
+	// referenced are the IDs of the named types fields reference.
+	referenced map[string]bool
+	// err is the first error formatting a type.
+	err error
+}
+
+// describe documents t, with the fields of the struct it names, if any.
+func (b *builder) describe(t spec.TypeRef) Type {
+	ret := Type{GoType: b.goType(t)}
+
+	n := b.svc.Lookup(derefType(t))
+	if n == nil || special(n) {
+		ret.Links = b.links(t)
+		return ret
+	}
+
+	ret.Name = b.name(n)
+	ret.Doc = n.Doc
+	if n.Struct == nil {
+		ret.Underlying = b.goType(*n.Underlying)
+		ret.Links = b.links(*n.Underlying)
+		return ret
+	}
+
+	for _, p := range b.svc.JSONProperties(n.Struct) {
+		ret.Fields = append(ret.Fields, Field{
+			Name:     p.Field.Name,
+			GoType:   b.goType(p.Field.Type),
+			Links:    b.links(p.Field.Type),
+			JSON:     p.Name,
+			Optional: p.Optional,
+			Doc:      p.Field.Doc,
+		})
+	}
+
+	return ret
+}
+
+// links lists the named types t references that are documented in the Types
+// section, and marks them referenced.
+func (b *builder) links(t spec.TypeRef) []string {
+	var ret []string
+	var walk func(t spec.TypeRef)
+	walk = func(t spec.TypeRef) {
+		switch t.Kind {
+		case spec.KindNamed:
+			if n := b.svc.Lookup(t); n != nil && !special(n) {
+				b.referenced[t.ID()] = true
+				ret = append(ret, b.name(n))
+			}
+			for _, arg := range t.TypeArgs {
+				walk(arg)
+			}
+		case spec.KindPointer, spec.KindSlice, spec.KindArray, spec.KindChan:
+			walk(*t.Elem)
+		case spec.KindMap:
+			walk(*t.Key)
+			walk(*t.Elem)
+		case spec.KindStruct:
+			for _, p := range b.svc.JSONProperties(t.Struct) {
+				walk(p.Field.Type)
+			}
+		}
+	}
+	walk(t)
+
+	return ret
+}
+
+// goType formats t as Go source, qualifying types declared outside the
+// service's package.
+func (b *builder) goType(t spec.TypeRef) string {
+	return b.typeString(t, b.qualify)
+}
+
+// name names a documented type, with its type args if it's an instance of a
+// generic type (e.g. `Page[Item]`).
+func (b *builder) name(n *spec.Named) string {
+	return b.typeString(n.Ref(), func(spec.TypeRef) string {
+		return ""
+	})
+}
+
+// typeString formats t as Go source. If it fails, it records the error, which
+// build returns.
+func (b *builder) typeString(t spec.TypeRef, qualifier func(spec.TypeRef) string) string {
+	s, err := t.GoString(qualifier)
+	if err != nil && b.err == nil {
+		b.err = err
+	}
+	return s
+}
+
+func (b *builder) qualify(t spec.TypeRef) string {
+	if t.Package == b.svc.Package {
+		return ""
+	}
+	return t.PackageName
+}
+
+// example returns example payloads of a call of m, and the language they're
+// written in: JSON-RPC for gorilla/rpc services, and Go values for net/rpc
+// services, whose codec is up to the server.
+func (b *builder) example(m spec.Method) (string, string, string) {
+	if b.svc.Provider != "gorilla" {
+		request, err := b.examples.Go(m.Arg, b.qualify)
+		if err != nil && b.err == nil {
+			b.err = err
+		}
+		response, err := b.examples.Go(m.Reply, b.qualify)
+		if err != nil && b.err == nil {
+			b.err = err
+		}
+		return request, response, "go"
+	}
+
+	request, response := b.examples.JSONRPC(m)
+	return string(request), string(response), "json"
+}
+
+// special reports whether n is a standard library type documented elsewhere
+// (e.g. `time.Time`) or a type with a custom encoding, whose fields don't
+// describe its encoding.
+func special(n *spec.Named) bool {
+	if n.Package == "time" || strings.HasPrefix(n.Package, "encoding/") {
+		return true
+	}
+	return n.Implements(spec.MarshalerJSON) || n.Implements(spec.MarshalerText)
+}
+
+func derefType(t spec.TypeRef) spec.TypeRef {
+	if t.Kind == spec.KindPointer {
+		return *t.Elem
+	}
+	return t
+}
+
+// anchor returns the fragment linking to the heading of name, as GitHub
+// derives it: lower case, without punctuation.
+func anchor(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		case r == ' ' || r == '-':
+			return '-'
+		case r == '_':
+			return r
+		}
+		return -1
+	}, name)
+}
+
+// cell escapes text for a Markdown table cell.
+func cell(text string) string {
+	text = strings.Replace(text, "|", `\|`, -1)
+	return strings.Replace(text, "\n", " ", -1)
+}
+
+// paragraphs splits text into paragraphs on blank lines.
+func paragraphs(text string) []string {
+	var ret []string
+	for _, p := range strings.Split(text, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// header renders h as an HTML comment, which html/template strips from
+// templates.
+func header(h generator.Header) htmltemplate.HTML {
+	lines := []string{"Code generated by glue. DO NOT EDIT."}
+	if h.Version != "" {
+		lines[0] = "Code generated by glue " + h.Version + ". DO NOT EDIT."
+	}
+	if h.Source != "" {
+		lines = append(lines, "Source: "+h.Source)
+	}
+	if h.Command != "" {
+		lines = append(lines, "Command: "+h.Command)
+	}
+
+	// Comments can't contain "--".
+	text := strings.Replace(strings.Join(lines, "\n"), "--", "- -", -1)
+	return htmltemplate.HTML("<!-- " + text + "\n-->")
+}
@@ -0,0 +1,213 @@
+// Package example synthesizes example values of the types of a service, e.g.
+// to document requests and replies.
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/glue/spec"
+)
+
+// maxArrayLen bounds the number of elements of example arrays.
+const maxArrayLen = 3
+
+// A Generator synthesizes example values of the types of a service. Values are
+// placeholders picked by type and, for fields, by name (e.g. an email address
+// for an `Email` string). Recursive types end with null.
+type Generator struct {
+	svc *spec.Service
+	// visiting are the IDs of the named types being synthesized.
+	visiting map[string]bool
+	// err is the first error of the Go literal being synthesized.
+	err error
+}
+
+// New creates a Generator of values of svc's types.
+func New(svc *spec.Service) *Generator {
+	return &Generator{svc: svc, visiting: map[string]bool{}}
+}
+
+// JSON returns an example of t as encoding/json encodes it, indented.
+func (g *Generator) JSON(t spec.TypeRef) []byte {
+	var b bytes.Buffer
+	g.json(&b, t, "")
+	return indent(b.Bytes())
+}
+
+// JSONRPC returns examples of the request and response of a call of m, in the
+// envelopes of gorilla/rpc's json codec (JSON-RPC 1.0), indented.
+func (g *Generator) JSONRPC(m spec.Method) ([]byte, []byte) {
+	var request, response bytes.Buffer
+	request.WriteString(`{"method":`)
+	writeJSON(&request, m.RPC)
+	request.WriteString(`,"params":[`)
+	g.json(&request, m.Arg, "")
+	request.WriteString(`],"id":1}`)
+
+	response.WriteString(`{"result":`)
+	g.json(&response, m.Reply, "")
+	response.WriteString(`,"error":null,"id":1}`)
+
+	return indent(request.Bytes()), indent(response.Bytes())
+}
+
+// json writes an example of t, the type of the field named name, if any.
+func (g *Generator) json(b *bytes.Buffer, t spec.TypeRef, name string) {
+	switch t.Kind {
+	case spec.KindBasic:
+		writeJSON(b, basic(t.Name, name))
+		return
+	case spec.KindNamed:
+		g.named(b, t, name)
+		return
+	case spec.KindPointer:
+		g.json(b, *t.Elem, name)
+		return
+	case spec.KindSlice, spec.KindArray:
+		// encoding/json encodes byte slices, but not arrays, as base64.
+		if e := *t.Elem; t.Kind == spec.KindSlice && e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			writeJSON(b, []byte("example"))
+			return
+		}
+
+		n := int64(1)
+		if t.Kind == spec.KindArray {
+			n = t.Len
+			if n > maxArrayLen {
+				n = maxArrayLen
+			}
+		}
+
+		b.WriteByte('[')
+		for i := int64(0); i < n; i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			g.json(b, *t.Elem, singular(name))
+		}
+		b.WriteByte(']')
+		return
+	case spec.KindMap:
+		b.WriteByte('{')
+		writeJSON(b, key(basic(g.svc.Underlying(*t.Key).Name, "")))
+		b.WriteByte(':')
+		g.json(b, *t.Elem, singular(name))
+		b.WriteByte('}')
+		return
+	case spec.KindStruct:
+		g.object(b, t.Struct)
+		return
+	}
+
+	// Interfaces can hold anything.
+	b.WriteString("null")
+}
+
+func (g *Generator) named(b *bytes.Buffer, t spec.TypeRef, name string) {
+	switch t.ID() {
+	case "time.Time":
+		writeJSON(b, Time)
+		return
+	case "time.Duration":
+		writeJSON(b, int64(Duration))
+		return
+	case "encoding/json.RawMessage", "encoding/json/jsontext.Value":
+		b.WriteString("{}")
+		return
+	case "encoding/json.Number":
+		b.WriteString("42")
+		return
+	}
+
+	n := g.svc.Lookup(t)
+	// Types with custom encodings are encoded in ways glue can't tell.
+	if n == nil || n.Implements(spec.MarshalerJSON) {
+		b.WriteString("null")
+		return
+	}
+	if n.Implements(spec.MarshalerText) {
+		writeJSON(b, basic("string", name))
+		return
+	}
+
+	if g.visiting[t.ID()] {
+		b.WriteString("null")
+		return
+	}
+	g.visiting[t.ID()] = true
+	defer delete(g.visiting, t.ID())
+
+	if n.Struct != nil {
+		g.object(b, n.Struct)
+		return
+	}
+	g.json(b, *n.Underlying, name)
+}
+
+func (g *Generator) object(b *bytes.Buffer, st *spec.Struct) {
+	b.WriteByte('{')
+	for i, p := range g.svc.JSONProperties(st) {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writeJSON(b, p.Name)
+		b.WriteByte(':')
+
+		if !p.String {
+			g.json(b, p.Field.Type, p.Field.Name)
+			continue
+		}
+
+		// The `string` option encodes the value within a string.
+		var v bytes.Buffer
+		g.json(&v, p.Field.Type, p.Field.Name)
+		writeJSON(b, v.String())
+	}
+	b.WriteByte('}')
+}
+
+func indent(src []byte) []byte {
+	var b bytes.Buffer
+	if err := json.Indent(&b, src, "", "  "); err != nil {
+		// Values are built from valid JSON fragments.
+		panic(err)
+	}
+	return b.Bytes()
+}
+
+func writeJSON(b *bytes.Buffer, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	b.Write(data)
+}
+
+// key formats the example of a map key, as encoding/json does for integers.
+func key(v interface{}) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	}
+	// Other key types can't be encoded.
+	return "key"
+}
+
+// singular guesses the singular of a plural field name (e.g. `Items` becomes
+// `Item`), to name the elements of lists.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "ses"), strings.HasSuffix(name, "xes"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name
+}
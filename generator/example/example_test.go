@@ -0,0 +1,155 @@
+package example_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/example"
+	"github.com/segmentio/glue/provider/stl"
+	"github.com/segmentio/glue/spec"
+)
+
+const src = `package d
+
+type Level int
+
+type Node struct {
+	Name string
+	Kids []Node
+	M    map[Level]Node
+	Next *Node
+}
+
+type Tree map[string]Tree
+
+type Blob []byte
+
+type Service struct{}
+
+func (s *Service) Recursive(arg Node, reply *Tree) error          { return nil }
+func (s *Service) Any(arg interface{}, reply *interface{}) error  { return nil }
+func (s *Service) Bytes(arg []byte, reply *Blob) error            { return nil }
+func (s *Service) Array(arg [3]Level, reply *[2][]Node) error     { return nil }
+func (s *Service) Basic(arg Level, reply *float32) error          { return nil }
+`
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// check type-checks the source of a package importing pkg.
+func check(t *testing.T, fset *token.FileSet, pkg *types.Package, code []byte) {
+	t.Helper()
+
+	f, err := parser.ParseFile(fset, "examples.go", code, 0)
+	if err != nil {
+		t.Fatalf("%s\n%s", err, code)
+	}
+
+	std := importer.ForCompiler(fset, "source", nil)
+	conf := types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+		if path == pkg.Path() {
+			return pkg, nil
+		}
+		return std.Import(path)
+	})}
+	if _, err := conf.Check("example.com/client", fset, []*ast.File{f}, nil); err != nil {
+		t.Fatalf("%s\n%s", err, code)
+	}
+}
+
+// build describes the service of src.
+func build(t *testing.T) (*token.FileSet, *types.Package, *spec.Service) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "d.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("example.com/d", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decl := pkg.Scope().Lookup("Service").Type().(*types.Named)
+	var funcs []*types.Func
+	for i := 0; i < decl.NumMethods(); i++ {
+		funcs = append(funcs, decl.Method(i))
+	}
+
+	svc := spec.Build(spec.Input{
+		Provider:    &stl.Provider{},
+		Service:     "D",
+		Declaration: decl,
+		Methods:     funcs,
+	})
+	return fset, pkg, svc
+}
+
+func TestGenerateGoCompiles(t *testing.T) {
+	fset, pkg, svc := build(t)
+
+	code, err := example.Generate(generator.GenerateInput{
+		PackageName: "client",
+		Service:     svc,
+		Methods:     svc.Methods,
+		Identifier:  "D",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check(t, fset, pkg, code)
+
+	for _, want := range []string{
+		"var ExampleDAnyArg interface{} = nil",
+		"Kids: []d.Node{}",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("missing %q in\n%s", want, code)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	_, _, svc := build(t)
+	g := example.New(svc)
+
+	tests := []struct {
+		method string
+		arg    string
+		reply  string
+	}{
+		{"Any", "null", "null"},
+		{"Bytes", `"ZXhhbXBsZQ=="`, `"ZXhhbXBsZQ=="`},
+		{"Basic", "42", "3.14"},
+	}
+	for _, test := range tests {
+		m := svc.Method(test.method)
+		if got := string(g.JSON(m.Arg)); got != test.arg {
+			t.Errorf("%s arg = %s, want %s", test.method, got, test.arg)
+		}
+		if got := string(g.JSON(m.Reply)); got != test.reply {
+			t.Errorf("%s reply = %s, want %s", test.method, got, test.reply)
+		}
+	}
+
+	// Recursive types end where they recur.
+	for _, m := range svc.Methods {
+		if data := g.JSON(m.Arg); !json.Valid(data) {
+			t.Errorf("%s arg is invalid JSON: %s", m.Name, data)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"text/template"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
+
+	"golang.org/x/tools/imports"
+)
+
+var tmpl = template.Must(template.New("examples").Parse(string(generator.MustAsset("templates/examples.gohtml"))))
+
+// TemplateData structures input to the templates/examples.gohtml template.
+type TemplateData struct {
+	Header  generator.Header
+	Package string
+	Imports []generator.Import
+	// Examples are the example args and replies, by method.
+	Examples []Example
+}
+
+// An Example is an example value of the arg or reply of a method.
+type Example struct {
+	// Name is the name of the variable (e.g. `ExampleMathSumArg`).
+	Name string
+	// Of is either `arg` or `reply`.
+	Of string
+	// RPC is the name clients call (e.g. `Math.Sum`).
+	RPC string
+	// Value is the Go expression of the example.
+	Value string
+	// Type is the type of the variable, set when Value is untyped (i.e. `nil`).
+	Type string
+}
+
+// Generate renders examples of the args and replies of the methods, in the
+// encoding of the service's provider: a JSON object keyed by RPC name for
+// gorilla/rpc services, and Go variables for net/rpc services.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	if in.Service.Provider == "gorilla" {
+		return generateJSON(in)
+	}
+	return generateGo(in)
+}
+
+func generateJSON(in generator.GenerateInput) ([]byte, error) {
+	g := New(in.Service)
+
+	// Build the object by hand to keep the methods in order.
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, m := range in.Methods {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writeJSON(&b, m.RPC)
+		b.WriteString(`:{"args":`)
+		b.Write(g.JSON(m.Arg))
+		b.WriteString(`,"reply":`)
+		b.Write(g.JSON(m.Reply))
+		b.WriteByte('}')
+	}
+	b.WriteByte('}')
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, b.Bytes(), "", "  "); err != nil {
+		log.OrDefault(in.Logger).Error("failed to format examples", log.Err(err))
+		return nil, err
+	}
+	indented.WriteByte('\n')
+
+	return indented.Bytes(), nil
+}
+
+func generateGo(in generator.GenerateInput) ([]byte, error) {
+	identifier := in.Identifier
+	if identifier == "" {
+		identifier = in.Service.Name
+	}
+
+	g := New(in.Service)
+	resolver := generator.NewResolver()
+	data := TemplateData{
+		Header:  in.Header,
+		Package: in.PackageName,
+	}
+	for _, m := range in.Methods {
+		arg, err := g.Go(m.Arg, resolver.Qualify)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to synthesize arg", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
+		reply, err := g.Go(m.Reply, resolver.Qualify)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to synthesize reply", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
+
+		name := "Example" + identifier + m.ClientName()
+		argExample, err := newExample(resolver, name+"Arg", "arg", m.RPC, arg, m.Arg)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to format arg type", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
+		replyExample, err := newExample(resolver, name+"Reply", "reply", m.RPC, reply, m.Reply)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to format reply type", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
+		data.Examples = append(data.Examples, argExample, replyExample)
+	}
+	data.Imports = resolver.GetImports()
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+
+	formatted, err := imports.Process("examples.go", src.Bytes(), nil)
+	if err != nil {
+		log.OrDefault(in.Logger).Error("failed to format code",
+			log.Err(err),
+			slog.String("code", src.String()))
+		return nil, err
+	}
+
+	return formatted, nil
+}
+
+// newExample describes the example value of t, declaring the type of the
+// variable if value is untyped.
+func newExample(resolver *generator.Resolver, name, of, rpc, value string, t spec.TypeRef) (Example, error) {
+	e := Example{Name: name, Of: of, RPC: rpc, Value: value}
+	if value != "nil" {
+		return e, nil
+	}
+
+	var err error
+	e.Type, err = resolver.GetTypeString(t)
+	return e, err
+}
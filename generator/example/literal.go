@@ -0,0 +1,254 @@
+package example
+
+import (
+	"go/format"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/segmentio/glue/spec"
+)
+
+// Go returns an example of t as a Go expression, formatted as gofmt does.
+// Types declared in other packages are qualified by qualifier (see
+// spec.TypeRef.GoString). Fields of types the package can't refer to, such as
+// unexported ones, are left out.
+func (g *Generator) Go(t spec.TypeRef, qualifier func(spec.TypeRef) string) (string, error) {
+	g.err = nil
+	expr := g.literal(t, "", qualifier)
+	if g.err != nil {
+		return "", g.err
+	}
+	if t.Kind == spec.KindBasic {
+		expr = typed(t.Name, expr)
+	}
+
+	// Format the expression as the value of a declaration, then strip it.
+	const prefix = "package p\n\nvar v = "
+	src, err := format.Source([]byte(prefix + expr + "\n"))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.TrimPrefix(string(src), prefix)), nil
+}
+
+// typeString formats t as Go source. If it fails, it records the error, which
+// Go returns.
+func (g *Generator) typeString(t spec.TypeRef, qualifier func(spec.TypeRef) string) string {
+	s, err := t.GoString(qualifier)
+	if err != nil && g.err == nil {
+		g.err = err
+	}
+	return s
+}
+
+// literal returns an example of t, the type of the field named name, if any.
+func (g *Generator) literal(t spec.TypeRef, name string, qualifier func(spec.TypeRef) string) string {
+	switch t.Kind {
+	case spec.KindBasic:
+		return basicLiteral(t.Name, name)
+	case spec.KindNamed:
+		return g.namedLiteral(t, name, qualifier)
+	case spec.KindPointer:
+		return g.pointerLiteral(t, name, qualifier)
+	case spec.KindSlice, spec.KindArray:
+		typ := g.typeString(t, qualifier)
+		if e := *t.Elem; t.Kind == spec.KindSlice && e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			return typ + `("example")`
+		}
+
+		n := int64(1)
+		if t.Kind == spec.KindArray {
+			n = t.Len
+			if n > maxArrayLen {
+				n = maxArrayLen
+			}
+		}
+
+		// Elements of types being synthesized (e.g. `Kids []Node` in `Node`)
+		// are left out rather than nil, which only some types can be.
+		elem := g.literal(*t.Elem, singular(name), qualifier)
+		if elem == "nil" {
+			return composite(typ, nil)
+		}
+
+		elems := make([]string, 0, n)
+		for i := int64(0); i < n; i++ {
+			elems = append(elems, elem)
+		}
+		return composite(typ, elems)
+	case spec.KindMap:
+		typ := g.typeString(t, qualifier)
+		k := `"key"`
+		if u := g.svc.Underlying(*t.Key); u.Kind != spec.KindBasic || u.Name != "string" {
+			k = g.literal(*t.Key, "", qualifier)
+		}
+		elem := g.literal(*t.Elem, singular(name), qualifier)
+		if k == "nil" || elem == "nil" {
+			return composite(typ, nil)
+		}
+		return composite(typ, []string{k + ": " + elem})
+	case spec.KindStruct:
+		return g.structLiteral(g.typeString(t, qualifier), t.Struct, qualifier)
+	}
+
+	// Interfaces can hold anything.
+	return "nil"
+}
+
+func (g *Generator) namedLiteral(t spec.TypeRef, name string, qualifier func(spec.TypeRef) string) string {
+	typ := g.typeString(t, qualifier)
+	pkg := strings.TrimSuffix(typ, t.Name)
+
+	switch t.ID() {
+	case "time.Time":
+		return pkg + "Date(2006, " + pkg + "January, 2, 15, 4, 5, 0, " + pkg + "UTC)"
+	case "time.Duration":
+		return "2 * " + pkg + "Second"
+	case "encoding/json.RawMessage", "encoding/json/jsontext.Value":
+		return typ + `("{}")`
+	case "encoding/json.Number":
+		return typ + `("42")`
+	}
+
+	n := g.svc.Lookup(t)
+	if n == nil || g.visiting[t.ID()] {
+		return "nil"
+	}
+	g.visiting[t.ID()] = true
+	defer delete(g.visiting, t.ID())
+
+	if n.Struct != nil {
+		return g.structLiteral(typ, n.Struct, qualifier)
+	}
+
+	switch u := *n.Underlying; u.Kind {
+	case spec.KindBasic:
+		return typ + "(" + basicLiteral(u.Name, name) + ")"
+	case spec.KindSlice, spec.KindArray, spec.KindMap:
+		// Replace the type of the composite literal by the named type.
+		v := g.literal(u, name, qualifier)
+		return typ + strings.TrimPrefix(v, g.typeString(u, qualifier))
+	}
+
+	return "nil"
+}
+
+func (g *Generator) pointerLiteral(t spec.TypeRef, name string, qualifier func(spec.TypeRef) string) string {
+	elem := *t.Elem
+	if elem.Kind == spec.KindNamed && g.visiting[elem.ID()] {
+		return "nil"
+	}
+
+	v := g.literal(elem, name, qualifier)
+	if v == "nil" {
+		return v
+	}
+	if strings.HasSuffix(v, "}") && !strings.HasPrefix(v, "func") {
+		// The address of a composite literal.
+		return "&" + v
+	}
+
+	// Other values need a variable to take the address of.
+	typ := g.typeString(elem, qualifier)
+	if elem.Kind == spec.KindBasic {
+		v = typed(typ, v)
+	}
+	return "func() *" + typ + " { v := " + v + "; return &v }()"
+}
+
+func (g *Generator) structLiteral(typ string, st *spec.Struct, qualifier func(spec.TypeRef) string) string {
+	var fields []string
+	for _, f := range st.Fields {
+		if !isExported(f.Name) || !g.accessible(f.Type) {
+			continue
+		}
+
+		v := g.literal(f.Type, f.Name, qualifier)
+		if v == "nil" {
+			continue
+		}
+		fields = append(fields, f.Name+": "+v)
+	}
+
+	return composite(typ, fields)
+}
+
+// accessible reports whether other packages can refer to t, that is whether
+// it doesn't reference unexported types.
+func (g *Generator) accessible(t spec.TypeRef) bool {
+	switch t.Kind {
+	case spec.KindNamed:
+		if t.Package != "" && !isExported(t.Name) {
+			return false
+		}
+		for _, arg := range t.TypeArgs {
+			if !g.accessible(arg) {
+				return false
+			}
+		}
+	case spec.KindPointer, spec.KindSlice, spec.KindArray, spec.KindChan:
+		return g.accessible(*t.Elem)
+	case spec.KindMap:
+		return g.accessible(*t.Key) && g.accessible(*t.Elem)
+	case spec.KindStruct:
+		for _, f := range t.Struct.Fields {
+			// Unexported fields belong to the package declaring the struct.
+			if !isExported(f.Name) || !g.accessible(f.Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// maxInlineLen bounds the length of composite literals written on one line.
+const maxInlineLen = 60
+
+// composite returns a composite literal of typ, on one line if it's short, or
+// with an element per line.
+func composite(typ string, elems []string) string {
+	inline := strings.Join(elems, ", ")
+	if len(inline) <= maxInlineLen && !strings.Contains(inline, "\n") {
+		return typ + "{" + inline + "}"
+	}
+	return typ + "{\n" + strings.Join(elems, ",\n") + ",\n}"
+}
+
+// typed converts the constant v to the basic type typ, unless it's the
+// type constants of its kind default to.
+func typed(typ, v string) string {
+	switch {
+	case v == "nil", typ == "int", typ == "string", typ == "bool":
+		return v
+	case typ == "float64" && strings.ContainsAny(v, ".eE"):
+		return v
+	}
+	return typ + "(" + v + ")"
+}
+
+func basicLiteral(typ, name string) string {
+	switch v := basic(typ, name).(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return strconv.Quote(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+
+	if strings.HasPrefix(typ, "complex") {
+		return "1 + 2i"
+	}
+	return "nil"
+}
+
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
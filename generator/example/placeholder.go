@@ -0,0 +1,151 @@
+package example
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Time is the example of time.Time values: Go's reference time.
+var Time = time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+// Duration is the example of time.Duration values.
+const Duration = 2 * time.Second
+
+// basic returns an example of the basic type typ for the field named name:
+// a bool, int64, uint64, float64 or string, or nil if typ can't be encoded
+// (e.g. complex numbers).
+func basic(typ, name string) interface{} {
+	words := split(name)
+
+	switch typ {
+	case "bool":
+		return true
+	case "string":
+		return stringFor(words)
+	case "int", "int8", "int16", "int32", "int64", "rune":
+		return int64(intFor(words))
+	case "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte":
+		return uint64(intFor(words))
+	case "float32", "float64":
+		return floatFor(words)
+	}
+
+	return nil
+}
+
+// stringHints pick example strings by the words of field names, in order.
+var stringHints = []struct {
+	words   []string
+	example string
+}{
+	{[]string{"email", "mail"}, "jane@example.com"},
+	{[]string{"url", "uri", "link", "href", "endpoint"}, "https://example.com"},
+	{[]string{"host", "hostname", "domain"}, "example.com"},
+	{[]string{"ip", "addr", "address"}, "192.0.2.1"},
+	{[]string{"phone"}, "+1 555 0100"},
+	{[]string{"uuid", "id", "ids"}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	{[]string{"token", "secret", "key", "password"}, "s3cr3t"},
+	{[]string{"date", "day"}, "2006-01-02"},
+	{[]string{"time", "at", "timestamp"}, "2006-01-02T15:04:05Z"},
+	{[]string{"country"}, "US"},
+	{[]string{"currency"}, "USD"},
+	{[]string{"lang", "language", "locale"}, "en"},
+	{[]string{"status", "state"}, "active"},
+	{[]string{"description", "text", "message", "comment", "body", "summary"}, "Lorem ipsum dolor sit amet."},
+	{[]string{"title"}, "Example"},
+	{[]string{"user", "username", "login", "owner", "author"}, "jane"},
+	{[]string{"name"}, "Jane Doe"},
+}
+
+func stringFor(words []string) string {
+	for _, h := range stringHints {
+		if hasWord(words, h.words) {
+			return h.example
+		}
+	}
+
+	if len(words) > 0 {
+		return strings.Join(words, " ")
+	}
+	return "string"
+}
+
+func intFor(words []string) int {
+	switch {
+	case hasWord(words, []string{"id", "ids"}):
+		return 1234
+	case hasWord(words, []string{"count", "total", "num", "size", "limit", "len", "length", "max", "min", "quantity"}):
+		return 10
+	case hasWord(words, []string{"page"}):
+		return 1
+	case hasWord(words, []string{"offset", "index", "skip"}):
+		return 0
+	case hasWord(words, []string{"age"}):
+		return 30
+	case hasWord(words, []string{"port"}):
+		return 8080
+	case hasWord(words, []string{"year"}):
+		return 2006
+	}
+	return 42
+}
+
+func floatFor(words []string) float64 {
+	switch {
+	case hasWord(words, []string{"price", "amount", "cost", "total", "balance"}):
+		return 9.99
+	case hasWord(words, []string{"lat", "latitude"}):
+		return 37.7749
+	case hasWord(words, []string{"lng", "lon", "longitude"}):
+		return -122.4194
+	case hasWord(words, []string{"ratio", "rate", "percent", "score", "probability"}):
+		return 0.5
+	}
+	return 3.14
+}
+
+// hasWord reports whether the last word of a field name is one of
+// candidates.
+func hasWord(words, candidates []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+
+	last := words[len(words)-1]
+	for _, c := range candidates {
+		if last == c {
+			return true
+		}
+	}
+	return false
+}
+
+// split splits a Go identifier into lower case words (e.g. `UserIDs` becomes
+// `user`, `ids`).
+func split(name string) []string {
+	runes := []rune(name)
+
+	var words []string
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i < len(runes) {
+			r, prev := runes[i], runes[i-1]
+			boundary := unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(i+1 < len(runes) && unicode.IsUpper(prev) && unicode.IsLower(runes[i+1]) && runes[i+1] != 's'))
+			if r == '_' || r == '-' || r == ' ' {
+				boundary = true
+			}
+			if !boundary {
+				continue
+			}
+		}
+
+		if word := strings.Trim(string(runes[start:i]), "_- "); word != "" {
+			words = append(words, strings.ToLower(word))
+		}
+		start = i
+	}
+
+	return words
+}
@@ -2,38 +2,144 @@ package generator
 
 import (
 	"bytes"
-	"go/types"
-	"log"
+	"fmt"
+	"go/token"
+	"log/slog"
+	"time"
 
-	"github.com/segmentio/glue/provider"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
 
 	"golang.org/x/tools/imports"
 )
 
 type GenerateInput struct {
-	Provider    provider.Provider
 	PackageName string
-	Service     string
+	// Service describes the RPC service.
+	Service *spec.Service
+	// Methods are the methods of Service the client calls.
+	Methods []spec.Method
+	// Identifier is the name of the generated client type. It defaults to Service.Name.
+	Identifier string
+	// Header describes where the generated code comes from.
+	Header Header
+	// Logger defaults to log.Default.
+	Logger *slog.Logger
+	// Lockfile is set for formats that keep state across runs (see Lockfile).
+	Lockfile *Lockfile
+	// Mocks also generates a mock of the client for tests, for formats that
+	// support it.
+	Mocks bool
+	// InProcess also generates a client.Client calling the server's methods
+	// directly, for tests, for formats that support it.
+	InProcess bool
+}
+
+// A Lockfile holds state a format keeps across runs, such as the field
+// numbers of .proto messages, so regenerating doesn't change it.
+type Lockfile struct {
+	// Data is the content of the lockfile written by the previous run, or nil.
+	// Generate replaces it with the content to write.
+	Data []byte
+}
 
-	Funcs []*types.Func
+// Header describes the provenance of generated code. It's rendered as the
+// standard `// Code generated ... DO NOT EDIT.` comment.
+type Header struct {
+	// Version is the version of glue. It's empty unless the version is stamped
+	// (see glue.Directions.StampVersion), so that the output of a given source
+	// doesn't change with every build of glue.
+	Version string
+	// Command is the command line or config entry that produced the code.
+	Command string
+	// Source is the import path of the server package.
+	Source string
+}
+
+// RequireGorilla returns an error unless svc is a gorilla/rpc service, for
+// outputs, named by what, that call it with JSON-RPC over HTTP, which net/rpc
+// services don't serve.
+func RequireGorilla(svc *spec.Service, what string) error {
+	if svc.Provider != "gorilla" {
+		return fmt.Errorf("%s require a gorilla/rpc service (-gorilla), found provider %s", what, svc.Provider)
+	}
+	return nil
 }
 
 func Generate(in GenerateInput) ([]byte, error) {
 	data := TemplateData{
-		Package: in.PackageName,
-		Service: in.Service,
+		Package:    in.PackageName,
+		Service:    in.Service.Name,
+		Identifier: in.Identifier,
+		Header:     in.Header,
+		Mocks:      in.Mocks,
+		InProcess:  in.InProcess,
+		Provider:   in.Service.Provider,
 	}
+	if data.Identifier == "" {
+		data.Identifier = in.Service.Name
+	}
+
+	resolver := NewResolver()
+	for _, m := range in.Methods {
+		argType, err := resolver.GetTypeString(m.Arg)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to format arg type", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
+		replyType, err := resolver.GetTypeString(m.Reply)
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to format reply type", slog.String(log.KeyMethod, m.Name), log.Err(err))
+			return nil, err
+		}
 
-	resolver := newResolver()
-	for _, f := range in.Funcs {
-		argT := in.Provider.GetArgType(f)
-		replyT := in.Provider.GetReplyType(f)
+		// net/rpc makes map and slice replies, whose contents servers may
+		// then set. gorilla/rpc leaves them nil.
+		var replyMake string
+		if in.Service.Provider != "gorilla" {
+			switch u := in.Service.Underlying(m.Reply); u.Kind {
+			case spec.KindMap, spec.KindSlice:
+				replyMake = string(u.Kind)
+			}
+		}
 
+		a := m.Annotations
 		data.Methods = append(data.Methods, MethodTemplate{
-			Name:      f.Name(),
-			ArgType:   resolver.GetTypeString(argT),
-			ReplyType: resolver.GetTypeString(replyT),
+			Name:            m.ClientName(),
+			RPCName:         m.Name,
+			ArgType:         argType,
+			ReplyType:       replyType,
+			ArgPointer:      m.ArgPointer,
+			ReplyMake:       replyMake,
+			Deprecated:      a.Deprecated,
+			DeprecationNote: a.DeprecationNote,
+			Timeout:         time.Duration(a.Timeout),
+			Idempotent:      a.Idempotent,
+		})
+	}
+
+	if in.InProcess {
+		// In-process clients refer to the server declaration from the
+		// client package.
+		svc := in.Service
+		if !token.IsExported(svc.Declaration) {
+			return nil, fmt.Errorf("in-process clients require an exported declaration, found %s", svc.Declaration)
+		}
+		if svc.PackageName == "main" {
+			return nil, fmt.Errorf("in-process clients can't import the server package %s: it's a command (package main)", svc.Package)
+		}
+
+		server, err := resolver.GetTypeString(spec.TypeRef{
+			Kind:        spec.KindNamed,
+			Name:        svc.Declaration,
+			Package:     svc.Package,
+			PackageName: svc.PackageName,
 		})
+		if err != nil {
+			log.OrDefault(in.Logger).Error("failed to format server type", log.Err(err))
+			return nil, err
+		}
+		data.Server = server
 	}
 
 	data.Imports = resolver.GetImports()
@@ -41,13 +147,15 @@ func Generate(in GenerateInput) ([]byte, error) {
 	var src bytes.Buffer
 	err := tmpl.Execute(&src, data)
 	if err != nil {
-		log.Printf("failed to render template: %s", err.Error())
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
 		return nil, err
 	}
 
 	formatted, err := imports.Process("client.go", src.Bytes(), nil)
 	if err != nil {
-		log.Printf("failed to format code: \nCODE:\n%s \nERR:\n%s", src.String(), err.Error())
+		log.OrDefault(in.Logger).Error("failed to format code",
+			log.Err(err),
+			slog.String("code", src.String()))
 		return nil, err
 	}
 
@@ -0,0 +1,45 @@
+package jsonschema
+
+import (
+	"encoding/json"
+
+	"github.com/segmentio/glue/generator"
+)
+
+// Generate renders a JSON Schema document for the service's methods. Its
+// `$defs` hold the schema of every named type, referenced as
+// `#/$defs/<Name>`, and of the arg and reply of every method, as
+// `#/$defs/<Service>.<Method>.args` and `.reply`.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	svc := in.Service
+	conv := NewConverter(svc, "#/$defs/")
+
+	doc := &Schema{
+		Schema:      Draft,
+		Title:       in.Identifier,
+		Description: svc.Doc,
+		Defs:        conv.Defs,
+	}
+	if doc.Title == "" {
+		doc.Title = svc.Name
+	}
+
+	for _, m := range in.Methods {
+		args := withDescription(conv.Schema(m.Arg), "Arg of "+m.RPC+".")
+		reply := withDescription(conv.Schema(m.Reply), "Reply of "+m.RPC+".")
+		if m.Annotations.Deprecated {
+			args.Deprecated = true
+			reply.Deprecated = true
+		}
+
+		conv.Defs[m.RPC+".args"] = args
+		conv.Defs[m.RPC+".reply"] = reply
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
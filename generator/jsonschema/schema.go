@@ -0,0 +1,300 @@
+// Package jsonschema converts the types of a service description into JSON
+// Schemas (draft 2020-12) matching how encoding/json encodes them.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/segmentio/glue/spec"
+)
+
+// Draft is the URI of the JSON Schema dialect glue emits.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// A Schema is a JSON Schema. Only the keywords glue emits are supported.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is a JSON type (e.g. `string`) or, for nullable types, a list of them.
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int64             `json:"minItems,omitempty"`
+	MaxItems             *int64             `json:"maxItems,omitempty"`
+	Properties           Properties         `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// A Property is a property of an object schema.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties are the properties of an object schema. Unlike a map, they keep
+// the order of struct fields when encoded.
+type Properties []Property
+
+// MarshalJSON satisfies json.Marshaler.
+func (p Properties) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, prop := range p {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		name, err := json.Marshal(prop.Name)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := json.Marshal(prop.Schema)
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(schema)
+	}
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// A Converter converts the types of a service into schemas. Named types are
+// converted once into Defs and referenced by RefPrefix followed by their
+// definition name.
+type Converter struct {
+	Service *spec.Service
+	// RefPrefix locates Defs in the final document (e.g. `#/$defs/`).
+	RefPrefix string
+	// Defs are the schemas of the named types converted so far, keyed by
+	// definition name: the type's name, qualified by its package name if
+	// several packages declare a type with that name.
+	Defs map[string]*Schema
+
+	// names are the definition names of named types by ID.
+	names map[string]string
+}
+
+// NewConverter creates a Converter.
+func NewConverter(svc *spec.Service, refPrefix string) *Converter {
+	return &Converter{
+		Service:   svc,
+		RefPrefix: refPrefix,
+		Defs:      map[string]*Schema{},
+		names:     map[string]string{},
+	}
+}
+
+// Schema converts t.
+func (c *Converter) Schema(t spec.TypeRef) *Schema {
+	switch t.Kind {
+	case spec.KindBasic:
+		return basic(t.Name)
+	case spec.KindNamed:
+		return c.named(t)
+	case spec.KindPointer:
+		return nullable(c.Schema(*t.Elem))
+	case spec.KindSlice:
+		// encoding/json encodes []byte as base64, and nil slices as null.
+		if e := *t.Elem; e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			return nullable(&Schema{Type: "string", ContentEncoding: "base64"})
+		}
+		return nullable(&Schema{Type: "array", Items: c.Schema(*t.Elem)})
+	case spec.KindArray:
+		n := t.Len
+		return &Schema{Type: "array", Items: c.Schema(*t.Elem), MinItems: &n, MaxItems: &n}
+	case spec.KindMap:
+		s := &Schema{Type: "object", AdditionalProperties: c.Schema(*t.Elem)}
+		// Integer keys are encoded as decimal strings.
+		if k := c.Service.Underlying(*t.Key); k.Kind == spec.KindBasic && isInteger(k.Name) {
+			s.PropertyNames = &Schema{Pattern: "^-?[0-9]+$"}
+		}
+		// Nil maps are encoded as null.
+		return nullable(s)
+	case spec.KindStruct:
+		return c.object(t.Struct)
+	}
+
+	// Interfaces can hold anything.
+	return &Schema{}
+}
+
+func (c *Converter) named(t spec.TypeRef) *Schema {
+	switch t.ID() {
+	case "time.Time":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "encoding/json.RawMessage", "encoding/json/jsontext.Value":
+		return &Schema{}
+	case "encoding/json.Number":
+		return &Schema{Type: "number"}
+	}
+
+	n := c.Service.Lookup(t)
+	if n == nil {
+		// Predeclared types (i.e. error).
+		return &Schema{}
+	}
+
+	if n.Implements(spec.MarshalerJSON) {
+		return &Schema{Description: n.Doc}
+	}
+	if n.Implements(spec.MarshalerText) {
+		return &Schema{Type: "string", Description: n.Doc}
+	}
+
+	name, ok := c.names[t.ID()]
+	if !ok {
+		name = c.defName(n)
+		c.names[t.ID()] = name
+
+		// Reserve the definition before converting it so recursive types
+		// reference it.
+		def := &Schema{}
+		c.Defs[name] = def
+		if n.Struct != nil {
+			*def = *c.object(n.Struct)
+		} else {
+			*def = *c.Schema(*n.Underlying)
+		}
+		def.Title = n.Ident()
+		def.Description = n.Doc
+	}
+
+	return &Schema{Ref: c.RefPrefix + name}
+}
+
+// defName names the definition of n after the type, then after its package if
+// that's taken, numbered if packages of the same name declare it.
+func (c *Converter) defName(n *spec.Named) string {
+	name := n.Ident()
+	if _, taken := c.Defs[name]; !taken {
+		return name
+	}
+
+	name = n.PackageName + "." + name
+	base := name
+	for i := 2; ; i++ {
+		if _, taken := c.Defs[name]; !taken {
+			return name
+		}
+		name = base + strconv.Itoa(i)
+	}
+}
+
+func (c *Converter) object(st *spec.Struct) *Schema {
+	s := &Schema{Type: "object", Properties: Properties{}}
+	for _, p := range c.Service.JSONProperties(st) {
+		prop := c.Schema(p.Field.Type)
+		if p.String {
+			prop = quoted(c.Service.Underlying(derefType(p.Field.Type)), p.Field.Type.Kind == spec.KindPointer)
+		}
+		if p.Field.Doc != "" {
+			prop = withDescription(prop, p.Field.Doc)
+		}
+
+		s.Properties = append(s.Properties, Property{Name: p.Name, Schema: prop})
+		if !p.Optional {
+			s.Required = append(s.Required, p.Name)
+		}
+	}
+
+	return s
+}
+
+func derefType(t spec.TypeRef) spec.TypeRef {
+	if t.Kind == spec.KindPointer {
+		return *t.Elem
+	}
+	return t
+}
+
+// withDescription returns a copy of s described by description, unless s is
+// already described (e.g. by the doc of a type implementing json.Marshaler).
+func withDescription(s *Schema, description string) *Schema {
+	if s.Description != "" {
+		return s
+	}
+
+	c := *s
+	c.Description = description
+	return &c
+}
+
+// quoted is the schema of a basic type encoded with the `string` option.
+func quoted(t spec.TypeRef, pointer bool) *Schema {
+	s := &Schema{Type: "string"}
+	switch {
+	case t.Name == "bool":
+		s.Enum = []interface{}{"true", "false"}
+	case isInteger(t.Name):
+		s.Pattern = "^-?[0-9]+$"
+	case t.Name == "float32" || t.Name == "float64":
+		s.Pattern = `^-?[0-9]+(\.[0-9]+)?([eE][-+]?[0-9]+)?$`
+	}
+
+	if pointer {
+		return nullable(s)
+	}
+	return s
+}
+
+func basic(name string) *Schema {
+	switch {
+	case name == "bool":
+		return &Schema{Type: "boolean"}
+	case name == "string":
+		return &Schema{Type: "string"}
+	case isInteger(name):
+		return &Schema{Type: "integer"}
+	case name == "float32" || name == "float64":
+		return &Schema{Type: "number"}
+	}
+
+	// e.g. complex numbers, which encoding/json can't encode.
+	return &Schema{}
+}
+
+func isInteger(name string) bool {
+	switch name {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune":
+		return true
+	}
+	return false
+}
+
+// nullable allows s to be null, as nil pointers, slices and maps are encoded.
+func nullable(s *Schema) *Schema {
+	if t, ok := s.Type.(string); ok && s.Ref == "" {
+		c := *s
+		c.Type = []string{t, "null"}
+		if c.Enum != nil {
+			c.Enum = append(append([]interface{}(nil), c.Enum...), nil)
+		}
+		return &c
+	}
+
+	// Anything is already nullable.
+	if s.Type == nil && s.Ref == "" && s.AnyOf == nil {
+		return s
+	}
+
+	return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+}
@@ -0,0 +1,114 @@
+// Package openrpc generates OpenRPC documents (https://spec.open-rpc.org)
+// describing JSON-RPC services, such as gorilla/rpc services served with its
+// json codec.
+package openrpc
+
+import (
+	"encoding/json"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/jsonschema"
+)
+
+// Version is the version of the OpenRPC specification documents conform to.
+const Version = "1.2.6"
+
+// A Document is an OpenRPC document.
+type Document struct {
+	OpenRPC    string     `json:"openrpc"`
+	Info       Info       `json:"info"`
+	Methods    []Method   `json:"methods"`
+	Components Components `json:"components"`
+}
+
+// Info describes the service.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// Version is the version of the service, which glue doesn't know. It's
+	// always `0.0.0`.
+	Version string `json:"version"`
+}
+
+// A Method is a JSON-RPC method.
+type Method struct {
+	// Name is the name clients call (e.g. `Math.Sum`).
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// ParamStructure is always `by-position`: the arg is the first and only
+	// param.
+	ParamStructure string              `json:"paramStructure"`
+	Params         []ContentDescriptor `json:"params"`
+	Result         ContentDescriptor   `json:"result"`
+	Deprecated     bool                `json:"deprecated,omitempty"`
+}
+
+// A ContentDescriptor describes a param or result.
+type ContentDescriptor struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Required    bool               `json:"required,omitempty"`
+	Schema      *jsonschema.Schema `json:"schema"`
+}
+
+// Components hold the schemas of named types, referenced by methods.
+type Components struct {
+	Schemas map[string]*jsonschema.Schema `json:"schemas"`
+}
+
+// Generate renders the OpenRPC document of the service's methods.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	svc := in.Service
+	if err := generator.RequireGorilla(svc, "OpenRPC documents"); err != nil {
+		return nil, err
+	}
+
+	conv := jsonschema.NewConverter(svc, "#/components/schemas/")
+
+	doc := Document{
+		OpenRPC: Version,
+		Info: Info{
+			Title:       in.Identifier,
+			Description: svc.Doc,
+			Version:     "0.0.0",
+		},
+		Methods: make([]Method, 0, len(in.Methods)),
+	}
+	if doc.Info.Title == "" {
+		doc.Info.Title = svc.Name
+	}
+
+	for _, m := range in.Methods {
+		description := m.Doc
+		if note := m.Annotations.DeprecationNote; note != "" {
+			if description != "" {
+				description += "\n\n"
+			}
+			description += "Deprecated: " + note
+		}
+
+		doc.Methods = append(doc.Methods, Method{
+			Name:           m.RPC,
+			Description:    description,
+			ParamStructure: "by-position",
+			Params: []ContentDescriptor{{
+				Name:     "args",
+				Required: true,
+				Schema:   conv.Schema(m.Arg),
+			}},
+			Result: ContentDescriptor{
+				Name:   "reply",
+				Schema: conv.Schema(m.Reply),
+			},
+			Deprecated: m.Annotations.Deprecated,
+		})
+	}
+	doc.Components.Schemas = conv.Defs
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,586 @@
+// Package proto generates Protocol Buffers (proto3) definitions of RPC
+// services, e.g. to migrate them to gRPC.
+//
+// Field numbers are recorded in a lockfile so regenerating never renumbers
+// fields: new fields get new numbers, and the numbers and names of removed
+// fields are reserved.
+package proto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
+)
+
+var tmpl = template.Must(
+	template.New("proto").Funcs(template.FuncMap{
+		"comment": comment,
+		"join":    strings.Join,
+	}).Parse(string(generator.MustAsset("templates/proto.protohtml"))),
+)
+
+// TemplateData structures input to the templates/proto.protohtml template.
+type TemplateData struct {
+	// Header describes the provenance of the generated code.
+	Header generator.Header
+	// Package is the proto package (e.g. `math`).
+	Package string
+	// Imports are the well-known types the messages use.
+	Imports []string
+	Service Service
+	// Messages are the messages of args, replies and the types they
+	// reference, in order of first use.
+	Messages []Message
+}
+
+// A Service is a proto service.
+type Service struct {
+	Name    string
+	Doc     string
+	Methods []Method
+}
+
+// A Method is an rpc of a Service.
+type Method struct {
+	Name       string
+	Arg        string
+	Reply      string
+	Doc        string
+	Deprecated bool
+	Idempotent bool
+}
+
+// A Message is a proto message.
+type Message struct {
+	Name   string
+	Doc    string
+	Fields []Field
+	// Reserved are the numbers of removed fields, and ReservedNames their
+	// quoted names.
+	Reserved      []string
+	ReservedNames []string
+}
+
+// A Field is a field of a Message.
+type Field struct {
+	// Label is `repeated`, `optional` or empty.
+	Label  string
+	Type   string
+	Name   string
+	Number int
+	// JSONName is the name of the field in JSON, if it differs from the
+	// default of protobuf's JSON mapping.
+	JSONName string
+	Doc      string
+}
+
+// Lock is the content of the lockfile: the field numbers of every message
+// generated so far, keyed by message name.
+type Lock struct {
+	Messages map[string]*MessageLock `json:"messages"`
+}
+
+// MessageLock holds the field numbers of a message.
+type MessageLock struct {
+	// Fields are the numbers of the current fields by name.
+	Fields map[string]int `json:"fields"`
+	// Removed are the numbers of removed fields by name. They're reserved, and
+	// reused if a field of that name comes back.
+	Removed map[string]int `json:"removed,omitempty"`
+}
+
+// Field numbers 19000 through 19999 are reserved by the protobuf
+// implementation.
+const (
+	firstReserved = 19000
+	lastReserved  = 19999
+)
+
+// Generate renders a .proto file declaring a service with an rpc per method,
+// and messages for their args and replies. Args and replies that aren't
+// structs are wrapped in a message with a single `value` field. Types without
+// a protobuf equivalent (e.g. interfaces) are reported in the returned error.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	lock := &Lock{}
+	if in.Lockfile != nil && len(in.Lockfile.Data) > 0 {
+		if err := json.Unmarshal(in.Lockfile.Data, lock); err != nil {
+			return nil, fmt.Errorf("invalid lockfile: %s", err.Error())
+		}
+	}
+	if lock.Messages == nil {
+		lock.Messages = map[string]*MessageLock{}
+	}
+
+	svc := in.Service
+	c := &converter{
+		svc:     svc,
+		lock:    lock,
+		names:   map[string]string{},
+		taken:   map[string]bool{},
+		imports: map[string]bool{},
+	}
+
+	data := TemplateData{
+		Header:  in.Header,
+		Package: svc.PackageName,
+		Service: Service{
+			Name: in.Identifier,
+			Doc:  svc.Doc,
+		},
+	}
+	if data.Service.Name == "" {
+		data.Service.Name = svc.Name
+	}
+
+	for _, m := range in.Methods {
+		data.Service.Methods = append(data.Service.Methods, Method{
+			Name:       m.Name,
+			Arg:        c.messageOf(m.Arg, m.Name+"Args"),
+			Reply:      c.messageOf(m.Reply, m.Name+"Reply"),
+			Doc:        methodDoc(m),
+			Deprecated: m.Annotations.Deprecated,
+			Idempotent: m.Annotations.Idempotent,
+		})
+	}
+
+	if len(c.errs) > 0 {
+		for _, e := range c.errs {
+			log.OrDefault(in.Logger).Error("type has no protobuf equivalent", slog.String("field", e))
+		}
+		return nil, errors.New("types without a protobuf equivalent:\n\t" + strings.Join(c.errs, "\n\t"))
+	}
+
+	data.Messages = c.messages
+	for path := range c.imports {
+		data.Imports = append(data.Imports, path)
+	}
+	sort.Strings(data.Imports)
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+
+	if in.Lockfile != nil {
+		lockData, err := json.MarshalIndent(lock, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		in.Lockfile.Data = append(lockData, '\n')
+	}
+
+	return src.Bytes(), nil
+}
+
+func methodDoc(m spec.Method) string {
+	doc := m.Doc
+	if note := m.Annotations.DeprecationNote; note != "" {
+		if doc != "" {
+			doc += "\n\n"
+		}
+		doc += "Deprecated: " + note
+	}
+	return doc
+}
+
+type converter struct {
+	svc      *spec.Service
+	lock     *Lock
+	messages []Message
+	// names are the message names of named types by ID.
+	names   map[string]string
+	taken   map[string]bool
+	imports map[string]bool
+	errs    []string
+}
+
+// fieldType is the type of a field.
+type fieldType struct {
+	name string
+	// repeated and optional are the label of the field. Maps are neither, but
+	// can't be repeated either.
+	repeated bool
+	optional bool
+	isMap    bool
+	message  bool
+}
+
+func (f fieldType) label() string {
+	switch {
+	case f.repeated:
+		return "repeated"
+	case f.optional:
+		return "optional"
+	}
+	return ""
+}
+
+// messageOf returns the message sending t as an arg or reply: the message of
+// a named struct, or a wrapper message named wrapper.
+func (c *converter) messageOf(t spec.TypeRef, wrapper string) string {
+	if n := c.svc.Lookup(t); n != nil && n.Struct != nil && !wellKnown(t) {
+		return c.message(t, n)
+	}
+
+	name := c.declare(wrapper, "")
+	c.define(name, []field{{name: "value", goName: "Value", t: t}}, name)
+	return name
+}
+
+func (c *converter) message(t spec.TypeRef, n *spec.Named) string {
+	if name, ok := c.names[t.ID()]; ok {
+		return name
+	}
+
+	name := n.Ident()
+	if c.taken[name] {
+		name = exported(n.PackageName) + n.Ident()
+	}
+	name = c.declare(name, n.Doc)
+	c.names[t.ID()] = name
+
+	c.define(name, c.fields(n.Struct), n.Ident())
+	return name
+}
+
+// declare reserves a message name, numbered if it's taken, and adds the
+// message before its fields are converted so recursive types reference it.
+func (c *converter) declare(name, doc string) string {
+	for i := 2; c.taken[name]; i++ {
+		name = strings.TrimRight(name, "0123456789") + strconv.Itoa(i)
+	}
+	c.taken[name] = true
+	c.messages = append(c.messages, Message{Name: name, Doc: doc})
+
+	return name
+}
+
+// A field is a field of a message before its type is converted.
+type field struct {
+	name     string
+	goName   string
+	jsonName string
+	t        spec.TypeRef
+	doc      string
+}
+
+// fields lists the fields of a struct: its JSON properties, so embedded
+// structs are flattened and `json:"-"` fields are left out.
+func (c *converter) fields(st *spec.Struct) []field {
+	var ret []field
+	for _, p := range c.svc.JSONProperties(st) {
+		ret = append(ret, field{
+			name:     snakeCase(p.Field.Name),
+			goName:   p.Field.Name,
+			jsonName: p.Name,
+			t:        p.Field.Type,
+			doc:      p.Field.Doc,
+		})
+	}
+	return ret
+}
+
+// define converts the fields of the message name, numbering them from the
+// lock.
+func (c *converter) define(name string, fields []field, path string) {
+	ml := c.lock.Messages[name]
+	if ml == nil {
+		ml = &MessageLock{}
+		c.lock.Messages[name] = ml
+	}
+
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, f.name)
+	}
+	numbers := ml.number(names)
+
+	var msg Message
+	for _, f := range fields {
+		ft, ok := c.fieldType(f.t, path+"."+f.goName)
+		if !ok {
+			continue
+		}
+
+		pf := Field{
+			Label:  ft.label(),
+			Type:   ft.name,
+			Name:   f.name,
+			Number: numbers[f.name],
+			Doc:    f.doc,
+		}
+		if f.jsonName != "" && f.jsonName != jsonName(f.name) {
+			pf.JSONName = f.jsonName
+		}
+		msg.Fields = append(msg.Fields, pf)
+	}
+
+	removed := make([]string, 0, len(ml.Removed))
+	for name := range ml.Removed {
+		removed = append(removed, name)
+	}
+	sort.Slice(removed, func(i, j int) bool {
+		return ml.Removed[removed[i]] < ml.Removed[removed[j]]
+	})
+	for _, name := range removed {
+		msg.Reserved = append(msg.Reserved, strconv.Itoa(ml.Removed[name]))
+		msg.ReservedNames = append(msg.ReservedNames, strconv.Quote(name))
+	}
+
+	for i := range c.messages {
+		if c.messages[i].Name == name {
+			c.messages[i].Fields = msg.Fields
+			c.messages[i].Reserved = msg.Reserved
+			c.messages[i].ReservedNames = msg.ReservedNames
+		}
+	}
+}
+
+// number assigns numbers to the fields of a message: the locked number of
+// known fields, and the next free numbers to new ones in order. Locked fields
+// missing from names are moved to Removed.
+func (ml *MessageLock) number(names []string) map[string]int {
+	current := map[string]int{}
+	next := 1
+	bump := func(n int) {
+		if n >= next {
+			next = n + 1
+		}
+	}
+	for _, n := range ml.Fields {
+		bump(n)
+	}
+	for _, n := range ml.Removed {
+		bump(n)
+	}
+
+	for _, name := range names {
+		if n, ok := ml.Fields[name]; ok {
+			current[name] = n
+		} else if n, ok := ml.Removed[name]; ok {
+			current[name] = n
+			delete(ml.Removed, name)
+		}
+	}
+	for _, name := range names {
+		if _, ok := current[name]; ok {
+			continue
+		}
+		if next >= firstReserved && next <= lastReserved {
+			next = lastReserved + 1
+		}
+		current[name] = next
+		next++
+	}
+
+	for name, n := range ml.Fields {
+		if _, ok := current[name]; !ok {
+			if ml.Removed == nil {
+				ml.Removed = map[string]int{}
+			}
+			ml.Removed[name] = n
+		}
+	}
+	ml.Fields = current
+
+	return current
+}
+
+// fieldType converts t, the type of the field at path (e.g. `SumArg.Values`),
+// or records why it can't be.
+func (c *converter) fieldType(t spec.TypeRef, path string) (fieldType, bool) {
+	switch t.Kind {
+	case spec.KindBasic:
+		if name := scalar(t.Name); name != "" {
+			return fieldType{name: name}, true
+		}
+	case spec.KindNamed:
+		switch t.ID() {
+		case "time.Time":
+			c.imports["google/protobuf/timestamp.proto"] = true
+			return fieldType{name: "google.protobuf.Timestamp", message: true}, true
+		case "time.Duration":
+			c.imports["google/protobuf/duration.proto"] = true
+			return fieldType{name: "google.protobuf.Duration", message: true}, true
+		}
+
+		n := c.svc.Lookup(t)
+		if n == nil {
+			break
+		}
+		if n.Struct != nil {
+			return fieldType{name: c.message(t, n), message: true}, true
+		}
+		return c.fieldType(*n.Underlying, path)
+	case spec.KindPointer:
+		ft, ok := c.fieldType(*t.Elem, path)
+		// Scalars need a label to tell nil from zero. Messages already do.
+		if ok && !ft.repeated && !ft.isMap && !ft.message {
+			ft.optional = true
+		}
+		return ft, ok
+	case spec.KindSlice, spec.KindArray:
+		if e := c.svc.Underlying(*t.Elem); e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			return fieldType{name: "bytes"}, true
+		}
+
+		ft, ok := c.fieldType(*t.Elem, path+"[]")
+		if !ok {
+			return ft, false
+		}
+		if ft.repeated || ft.isMap {
+			c.errorf(path, t, "repeated fields can't hold lists or maps")
+			return ft, false
+		}
+		return fieldType{name: ft.name, repeated: true}, true
+	case spec.KindMap:
+		key := c.svc.Underlying(*t.Key)
+		if key.Kind != spec.KindBasic || !validKey(key.Name) {
+			c.errorf(path, t, "map keys must be integers, strings or booleans")
+			return fieldType{}, false
+		}
+
+		ft, ok := c.fieldType(*t.Elem, path+"[]")
+		if !ok {
+			return ft, false
+		}
+		if ft.repeated || ft.isMap {
+			c.errorf(path, t, "map values can't be lists or maps")
+			return ft, false
+		}
+		return fieldType{name: "map<" + scalar(key.Name) + ", " + ft.name + ">", isMap: true}, true
+	case spec.KindStruct:
+		// Anonymous structs are named after their path (e.g. `GetArgAnon`).
+		name := c.declare(strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, path), "")
+		c.define(name, c.fields(t.Struct), path)
+		return fieldType{name: name, message: true}, true
+	}
+
+	c.errorf(path, t, "")
+	return fieldType{}, false
+}
+
+func (c *converter) errorf(path string, t spec.TypeRef, reason string) {
+	msg := path + ": " + t.String()
+	if reason != "" {
+		msg += " (" + reason + ")"
+	}
+	c.errs = append(c.errs, msg)
+}
+
+// wellKnown reports whether t maps to a well-known type rather than to a
+// message of its own.
+func wellKnown(t spec.TypeRef) bool {
+	return t.ID() == "time.Time"
+}
+
+// scalar returns the scalar type of a basic type, or an empty string if
+// there's none (e.g. complex numbers).
+func scalar(name string) string {
+	switch name {
+	case "bool":
+		return "bool"
+	case "string":
+		return "string"
+	case "int", "int64":
+		return "int64"
+	case "int8", "int16", "int32", "rune":
+		return "int32"
+	case "uint", "uint64", "uintptr":
+		return "uint64"
+	case "uint8", "uint16", "uint32", "byte":
+		return "uint32"
+	case "float32":
+		return "float"
+	case "float64":
+		return "double"
+	}
+	return ""
+}
+
+func validKey(name string) bool {
+	switch scalar(name) {
+	case "", "float", "double":
+		return false
+	}
+	return true
+}
+
+// snakeCase converts a Go identifier to snake case (e.g. `UserID` becomes
+// `user_id`).
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := !unicode.IsUpper(runes[i-1]) && runes[i-1] != '_'
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && runes[i-1] != '_'
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// jsonName returns the JSON name protobuf gives a field by default: its name
+// in lower camel case.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// exported capitalizes a package name to qualify message names (e.g. `math`
+// becomes `Math`).
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// commentData is the input of the "comment" template.
+type commentData struct {
+	Indent string
+	Lines  []string
+}
+
+func comment(indent, doc string) commentData {
+	data := commentData{Indent: indent}
+	if doc != "" {
+		data.Lines = strings.Split(doc, "\n")
+	}
+	return data
+}
@@ -0,0 +1,208 @@
+package proto_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/generator/proto"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/provider/stl"
+	"github.com/segmentio/glue/spec"
+)
+
+// build describes a service with a Sum method taking a SumArg declared with
+// fields.
+func build(t *testing.T, fields string) *spec.Service {
+	t.Helper()
+
+	src := `package math
+
+import "time"
+
+var _ time.Time
+
+type SumArg struct {
+` + fields + `
+}
+
+type Service struct{}
+
+func (s *Service) Sum(arg SumArg, reply *int) error { return nil }
+`
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "math.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("example.com/math", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decl := pkg.Scope().Lookup("Service").Type().(*types.Named)
+	var funcs []*types.Func
+	for i := 0; i < decl.NumMethods(); i++ {
+		funcs = append(funcs, decl.Method(i))
+	}
+
+	return spec.Build(spec.Input{
+		Provider:    &stl.Provider{},
+		Service:     "Math",
+		Declaration: decl,
+		Methods:     funcs,
+	})
+}
+
+func generate(svc *spec.Service, lock *generator.Lockfile) (string, error) {
+	code, err := proto.Generate(generator.GenerateInput{
+		Service:  svc,
+		Methods:  svc.Methods,
+		Lockfile: lock,
+		Logger:   log.Discard(),
+	})
+	return string(code), err
+}
+
+// message returns the lines in the body of the message name.
+func message(t *testing.T, code, name string) []string {
+	t.Helper()
+
+	start := strings.Index(code, "message "+name+" {\n")
+	if start < 0 {
+		t.Fatalf("missing message %s in\n%s", name, code)
+	}
+	body := code[start+len("message "+name+" {\n"):]
+	body = body[:strings.Index(body, "}")]
+
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+func TestLockfileNumbering(t *testing.T) {
+	// Each step regenerates SumArg with the lockfile of the previous one.
+	steps := []struct {
+		fields string
+		want   []string
+	}{
+		{
+			"A string\nB int",
+			[]string{`string a = 1 [json_name = "A"];`, `int64 b = 2 [json_name = "B"];`},
+		},
+		{
+			// New fields are numbered after every number used so far.
+			"B int\nC bool",
+			[]string{`reserved 1;`, `reserved "a";`, `int64 b = 2 [json_name = "B"];`, `bool c = 3 [json_name = "C"];`},
+		},
+		{
+			// Fields that come back get their old number.
+			"C bool\nA string",
+			[]string{`reserved 2;`, `reserved "b";`, `bool c = 3 [json_name = "C"];`, `string a = 1 [json_name = "A"];`},
+		},
+		{
+			"C bool\nA string\nD float64",
+			[]string{`reserved 2;`, `reserved "b";`, `bool c = 3 [json_name = "C"];`, `string a = 1 [json_name = "A"];`, `double d = 4 [json_name = "D"];`},
+		},
+		{
+			// Removed fields stay reserved in order of their numbers.
+			"D float64",
+			[]string{`reserved 1, 2, 3;`, `reserved "a", "b", "c";`, `double d = 4 [json_name = "D"];`},
+		},
+	}
+
+	lock := &generator.Lockfile{}
+	for i, step := range steps {
+		code, err := generate(build(t, step.fields), lock)
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if got := message(t, code, "SumArg"); !reflect.DeepEqual(got, step.want) {
+			t.Errorf("step %d: SumArg =\n\t%s\nwant\n\t%s", i, strings.Join(got, "\n\t"), strings.Join(step.want, "\n\t"))
+		}
+	}
+}
+
+func TestLockfileSkipsReservedRange(t *testing.T) {
+	lock := &generator.Lockfile{Data: []byte(`{"messages": {"SumArg": {"fields": {"a": 18999}}}}`)}
+
+	code, err := generate(build(t, "A string\nB int"), lock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{`string a = 18999 [json_name = "A"];`, `int64 b = 20000 [json_name = "B"];`}
+	if got := message(t, code, "SumArg"); !reflect.DeepEqual(got, want) {
+		t.Errorf("SumArg = %q, want %q", got, want)
+	}
+	if !strings.Contains(string(lock.Data), `"b": 20000`) {
+		t.Errorf("lockfile doesn't record b:\n%s", lock.Data)
+	}
+}
+
+func TestLockfileInvalid(t *testing.T) {
+	_, err := generate(build(t, "A string"), &generator.Lockfile{Data: []byte("{")})
+	if err == nil || !strings.Contains(err.Error(), "invalid lockfile") {
+		t.Errorf("err = %v, want an invalid lockfile error", err)
+	}
+}
+
+func TestGenerateTypes(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"A []byte", `bytes a = 1 [json_name = "A"];`},
+		{"A *int32", `optional int32 a = 1 [json_name = "A"];`},
+		{"A []string", `repeated string a = 1 [json_name = "A"];`},
+		{"A map[string]uint16", `map<string, uint32> a = 1 [json_name = "A"];`},
+		{"A time.Time", `google.protobuf.Timestamp a = 1 [json_name = "A"];`},
+		{"A time.Duration", `google.protobuf.Duration a = 1 [json_name = "A"];`},
+		{"UserID string", `string user_id = 1 [json_name = "UserID"];`},
+		{"A string `json:\"a\"`", "string a = 1;"},
+		{"A string `json:\"alpha\"`", `string a = 1 [json_name = "alpha"];`},
+	}
+	for _, test := range tests {
+		code, err := generate(build(t, test.field), nil)
+		if err != nil {
+			t.Errorf("%s: %v", test.field, err)
+			continue
+		}
+		if got := message(t, code, "SumArg"); len(got) != 1 || got[0] != test.want {
+			t.Errorf("%s: SumArg = %q, want %q", test.field, got, test.want)
+		}
+	}
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"A complex128", "SumArg.A: complex128"},
+		{"A chan int", "SumArg.A: chan int"},
+		{"A interface{}", "SumArg.A: interface{}"},
+		{"A map[float64]string", "map keys must be integers, strings or booleans"},
+		{"A [][]string", "repeated fields can't hold lists or maps"},
+		{"A map[string][]int", "map values can't be lists or maps"},
+	}
+	for _, test := range tests {
+		_, err := generate(build(t, test.field), nil)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: err = %v, want %q", test.field, err, test.want)
+		}
+	}
+}
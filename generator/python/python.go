@@ -0,0 +1,405 @@
+// Package python generates Python clients for JSON-RPC services served with
+// the json codec of gorilla/rpc. Generated modules require Python 3.11 or
+// later.
+package python
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
+)
+
+var tmpl = template.Must(
+	template.New("python").Funcs(template.FuncMap{
+		"comment":   comment,
+		"docstring": docstring,
+	}).Parse(string(generator.MustAsset("templates/python.pyhtml"))),
+)
+
+// TemplateData structures input to the templates/python.pyhtml template.
+type TemplateData struct {
+	// Header describes the provenance of the generated code.
+	Header generator.Header
+	// Identifier is the name of the client class, without its `Client` suffix.
+	Identifier string
+	// Doc is the doc comment of the service.
+	Doc string
+	// Types are the declarations of named types, in order of first use.
+	Types []Type
+	// Methods are the methods of the client class.
+	Methods []Method
+}
+
+// A Type declares a named type: a TypedDict of Fields, or an alias of Alias if
+// it's set.
+type Type struct {
+	Name   string
+	Doc    string
+	Fields []Field
+	Alias  string
+	// Functional is set when a field name isn't a valid Python identifier, so
+	// the TypedDict is declared with the functional syntax.
+	Functional bool
+}
+
+// A Field is a key of a TypedDict.
+type Field struct {
+	// Name is the JSON name of the key.
+	Name string
+	Type string
+	Doc  string
+}
+
+// A Method is a method of the client class.
+type Method struct {
+	// Name is the snake case client name of the method (e.g. `sum`),
+	// suffixed with underscores if another method has it.
+	Name string
+	// RPC is the name the method calls (e.g. `Math.Sum`).
+	RPC       string
+	ArgType   string
+	ReplyType string
+	Doc       string
+	// Deprecated methods emit a DeprecationWarning of DeprecationMessage.
+	Deprecated         bool
+	DeprecationMessage string
+	// Timeout is the timeout of calls in seconds (e.g. `2.5`), or empty.
+	Timeout string
+}
+
+// Generate renders a Python module declaring the arg and reply types of the
+// service's methods as TypedDicts, following encoding/json, and a client class
+// calling them.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	if err := generator.RequireGorilla(in.Service, "Python clients"); err != nil {
+		return nil, err
+	}
+
+	data := TemplateData{
+		Header:     in.Header,
+		Identifier: in.Identifier,
+		Doc:        in.Service.Doc,
+	}
+	if data.Identifier == "" {
+		data.Identifier = in.Service.Name
+	}
+
+	c := newConverter(in.Service)
+	// Keep the names the module declares.
+	for _, name := range []string{data.Identifier + "Client", "RPCError"} {
+		c.taken[name] = true
+	}
+
+	// Methods whose names collide once snake cased (e.g. `GetURL` and
+	// `GetUrl`) are told apart by underscores.
+	members := map[string]bool{}
+	for _, m := range in.Methods {
+		name := snakeCase(m.ClientName())
+		for members[name] {
+			name += "_"
+		}
+		members[name] = true
+
+		a := m.Annotations
+		method := Method{
+			Name:       name,
+			RPC:        m.RPC,
+			ArgType:    c.typeOf(m.Arg),
+			ReplyType:  c.typeOf(m.Reply),
+			Doc:        methodDoc(m),
+			Deprecated: a.Deprecated,
+		}
+		if a.Deprecated {
+			method.DeprecationMessage = m.RPC + " is deprecated"
+			if a.DeprecationNote != "" {
+				method.DeprecationMessage += ": " + a.DeprecationNote
+			}
+		}
+		if a.Timeout > 0 {
+			method.Timeout = strconv.FormatFloat(float64(a.Timeout)/1e9, 'f', -1, 64)
+			if !strings.Contains(method.Timeout, ".") {
+				method.Timeout += ".0"
+			}
+		}
+
+		data.Methods = append(data.Methods, method)
+	}
+	data.Types = c.types
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+
+	return src.Bytes(), nil
+}
+
+func methodDoc(m spec.Method) string {
+	var paragraphs []string
+	if m.Doc != "" {
+		paragraphs = append(paragraphs, m.Doc)
+	}
+	if m.Annotations.Idempotent {
+		paragraphs = append(paragraphs, "Idempotent: safe to retry.")
+	}
+	if m.Annotations.Deprecated {
+		paragraphs = append(paragraphs, strings.TrimSpace("Deprecated: "+m.Annotations.DeprecationNote))
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// converter converts the types of a service into Python type hints. Named
+// types are declared once and referenced by name, quoted so declarations can
+// refer to each other in any order.
+type converter struct {
+	svc   *spec.Service
+	types []Type
+	// names are the Python names of named types by ID.
+	names map[string]string
+	taken map[string]bool
+}
+
+func newConverter(svc *spec.Service) *converter {
+	return &converter{
+		svc:   svc,
+		names: map[string]string{},
+		taken: map[string]bool{},
+	}
+}
+
+// typeOf converts t into a type hint matching what encoding/json encodes it
+// as.
+func (c *converter) typeOf(t spec.TypeRef) string {
+	switch t.Kind {
+	case spec.KindBasic:
+		return basic(t.Name)
+	case spec.KindNamed:
+		return c.named(t)
+	case spec.KindPointer:
+		return optional(c.typeOf(*t.Elem))
+	case spec.KindSlice:
+		// encoding/json encodes []byte as base64, and nil slices as None.
+		if e := *t.Elem; e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			return optional("str")
+		}
+		return optional("list[" + c.typeOf(*t.Elem) + "]")
+	case spec.KindArray:
+		return "list[" + c.typeOf(*t.Elem) + "]"
+	case spec.KindMap:
+		// Nil maps are encoded as None.
+		return optional("dict[str, " + c.typeOf(*t.Elem) + "]")
+	case spec.KindStruct:
+		// TypedDicts can't be declared inline.
+		return "dict[str, typing.Any]"
+	}
+
+	// Interfaces can hold anything.
+	return "typing.Any"
+}
+
+func (c *converter) named(t spec.TypeRef) string {
+	switch t.ID() {
+	case "time.Time":
+		return "str"
+	case "encoding/json.RawMessage", "encoding/json/jsontext.Value":
+		return "typing.Any"
+	case "encoding/json.Number":
+		return "float"
+	}
+
+	n := c.svc.Lookup(t)
+	if n == nil {
+		// Predeclared types (i.e. error).
+		return "typing.Any"
+	}
+
+	if n.Implements(spec.MarshalerJSON) {
+		return "typing.Any"
+	}
+	if n.Implements(spec.MarshalerText) {
+		return "str"
+	}
+
+	name, ok := c.names[t.ID()]
+	if !ok {
+		name = c.declName(n)
+		c.names[t.ID()] = name
+		c.taken[name] = true
+
+		// Reserve the declaration before converting it so recursive types
+		// reference it.
+		i := len(c.types)
+		c.types = append(c.types, Type{Name: name, Doc: n.Doc})
+		if n.Struct != nil {
+			fields, functional := c.fields(n.Struct)
+			c.types[i].Fields = fields
+			c.types[i].Functional = functional
+		} else {
+			c.types[i].Alias = c.typeOf(*n.Underlying)
+		}
+	}
+
+	return strconv.Quote(name)
+}
+
+func (c *converter) declName(n *spec.Named) string {
+	name := n.Ident()
+	if !c.taken[name] && !keywords[name] {
+		return name
+	}
+
+	// Packages of the same name are numbered.
+	name = n.PackageName + "_" + name
+	base := name
+	for i := 2; c.taken[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	return name
+}
+
+// fields converts the JSON properties of st into TypedDict keys, and reports
+// whether one of them isn't a valid identifier.
+func (c *converter) fields(st *spec.Struct) ([]Field, bool) {
+	var ret []Field
+	functional := false
+	for _, p := range c.svc.JSONProperties(st) {
+		f := Field{
+			Name: p.Name,
+			Type: c.typeOf(p.Field.Type),
+			Doc:  p.Field.Doc,
+		}
+		if p.String {
+			f.Type = "str"
+			if p.Field.Type.Kind == spec.KindPointer {
+				f.Type = optional(f.Type)
+			}
+		}
+		if p.Optional {
+			f.Type = "typing.NotRequired[" + f.Type + "]"
+		}
+		if !isIdentifier(f.Name) {
+			functional = true
+		}
+
+		ret = append(ret, f)
+	}
+
+	return ret, functional
+}
+
+func basic(name string) string {
+	switch name {
+	case "bool":
+		return "bool"
+	case "string":
+		return "str"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune":
+		return "int"
+	case "float32", "float64":
+		return "float"
+	}
+
+	// e.g. complex numbers, which encoding/json can't encode.
+	return "typing.Any"
+}
+
+// optional allows t to be None, as nil pointers, slices and maps are encoded.
+func optional(t string) string {
+	if t == "typing.Any" || strings.HasPrefix(t, "typing.Optional[") {
+		return t
+	}
+	return "typing.Optional[" + t + "]"
+}
+
+// keywords are the reserved words of Python 3.
+var keywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true,
+	"assert": true, "async": true, "await": true, "break": true, "class": true,
+	"continue": true, "def": true, "del": true, "elif": true, "else": true,
+	"except": true, "finally": true, "for": true, "from": true, "global": true,
+	"if": true, "import": true, "in": true, "is": true, "lambda": true,
+	"nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+func isIdentifier(name string) bool {
+	if name == "" || keywords[name] {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// snakeCase converts a Go identifier to snake case (e.g. `URLFor` becomes
+// `url_for`), suffixed with an underscore if it's a keyword.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := !unicode.IsUpper(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	s := strings.Replace(b.String(), "__", "_", -1)
+	if keywords[s] {
+		s += "_"
+	}
+	return s
+}
+
+// comment renders doc as `#` comments indented by indent, followed by a
+// newline, or nothing if doc is empty.
+func comment(indent, doc string) string {
+	if doc == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, line := range strings.Split(doc, "\n") {
+		b.WriteString(strings.TrimRight(indent+"# "+line, " ") + "\n")
+	}
+	return b.String()
+}
+
+// docstring renders doc as a docstring indented by indent.
+func docstring(indent, doc string) string {
+	doc = strings.Replace(doc, `\`, `\\`, -1)
+	doc = strings.Replace(doc, `"""`, `\"\"\"`, -1)
+	if strings.HasSuffix(doc, `"`) {
+		doc = doc[:len(doc)-1] + `\"`
+	}
+
+	lines := strings.Split(doc, "\n")
+	if len(lines) == 1 {
+		return indent + `"""` + doc + `"""`
+	}
+
+	var b strings.Builder
+	b.WriteString(indent + `"""` + lines[0] + "\n")
+	for _, line := range lines[1:] {
+		b.WriteString(strings.TrimRight(indent+line, " ") + "\n")
+	}
+	b.WriteString(indent + `"""`)
+
+	return b.String()
+}
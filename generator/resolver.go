@@ -1,13 +1,15 @@
 package generator
 
 import (
-	"go/types"
-	"path/filepath"
+	"sort"
 	"strconv"
-	"strings"
+
+	"github.com/segmentio/glue/spec"
 )
 
-type resolver struct {
+// A Resolver qualifies the types of other packages in Go source, and tracks
+// the imports they need.
+type Resolver struct {
 	// imports is a map of package name to package path to an incrementing integer
 	// for a given package name, there may be multiple paths--
 	// e.g. "mypkg" is the name of paths "github.com/x/mypkg" and "github.com/y/mypkg"
@@ -28,40 +30,48 @@ type importMapping struct {
 	counter int
 }
 
-func newResolver() *resolver {
-	return &resolver{
+// NewResolver creates a Resolver with no imports.
+func NewResolver() *Resolver {
+	return &Resolver{
 		imports: map[string]map[string]importMapping{},
 	}
 }
 
-func (r *resolver) GetTypeString(t types.Type) string {
-	return types.TypeString(t, func(pkg *types.Package) string {
-		name := pkg.Name()
-		path := stripVendor(pkg.Path())
+// GetTypeString formats t as Go source, importing the packages it references.
+func (r *Resolver) GetTypeString(t spec.TypeRef) (string, error) {
+	return t.GoString(r.Qualify)
+}
 
-		if existingPaths, ok := r.imports[name]; ok {
-			mapping, ok := existingPaths[path]
-			if ok {
-				return mapping.Name
-			}
+// Qualify returns the name to qualify the named type t with, importing its
+// package.
+func (r *Resolver) Qualify(t spec.TypeRef) string {
+	name := t.PackageName
+	path := t.Package
 
-			n := len(existingPaths)
-			rename := name + strconv.Itoa(n)
-			r.imports[name][path] = importMapping{
-				Name:    rename,
-				counter: n,
-			}
+	if existingPaths, ok := r.imports[name]; ok {
+		mapping, ok := existingPaths[path]
+		if ok {
+			return mapping.Name
+		}
 
-			return rename
+		n := len(existingPaths)
+		rename := name + strconv.Itoa(n)
+		r.imports[name][path] = importMapping{
+			Name:    rename,
+			counter: n,
 		}
 
-		r.imports[name] = map[string]importMapping{}
-		r.imports[name][path] = importMapping{Name: name}
-		return name
-	})
+		return rename
+	}
+
+	r.imports[name] = map[string]importMapping{}
+	r.imports[name][path] = importMapping{Name: name}
+	return name
 }
 
-func (r *resolver) GetImports() []Import {
+// GetImports returns the imports of the packages referenced so far, sorted
+// by path so that generated code is the same on every run.
+func (r *Resolver) GetImports() []Import {
 	ret := make([]Import, 0, len(r.imports))
 	for originalName, pathsMap := range r.imports {
 		for path, info := range pathsMap {
@@ -76,26 +86,9 @@ func (r *resolver) GetImports() []Import {
 			})
 		}
 	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Path < ret[j].Path
+	})
 
 	return ret
 }
-
-// github.com/x/y/vendor/github.com/a/b -> github.com/a/b
-func stripVendor(path string) string {
-	dirs := strings.Split(path, string(filepath.Separator))
-	num := len(dirs)
-	vendorIndex := -1
-	for i := 1; i <= num; i++ {
-		dir := dirs[num-i]
-		if dir == "vendor" {
-			vendorIndex = num - i
-			break
-		}
-	}
-
-	if vendorIndex == -1 {
-		return path
-	}
-
-	return filepath.Join(dirs[vendorIndex+1:]...)
-}
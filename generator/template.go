@@ -1,12 +1,18 @@
 package generator
 
-import "html/template"
+import (
+	"fmt"
+	"text/template"
+	"time"
+)
 
 //go:generate go-bindata -nomemcopy -pkg generator templates/...
-var tmpl = mustParseTemplate("templates/client.gohtml")
+var tmpl = mustParseTemplate("templates/client.gohtml", "templates/mock.gohtml", "templates/inprocess.gohtml")
 
 // TemplateData structures input to the template/client.gohtml template.
 type TemplateData struct {
+	// Header describes the provenance of the generated code.
+	Header Header
 	// Package is the name of the output package.
 	Package string
 	// Service is the name of the service.
@@ -17,16 +23,41 @@ type TemplateData struct {
 	Identifier string
 	// Methods is a list of method metadata.
 	Methods []MethodTemplate
+	// Mocks adds a mock of the client (see GenerateInput.Mocks).
+	Mocks bool
+	// InProcess adds an in-process client.Client calling Server (see
+	// GenerateInput.InProcess).
+	InProcess bool
+	// Server is the type declaring the methods (e.g. `math.Service`).
+	Server string
+	// Provider is the name of the provider (e.g. `gorilla`).
+	Provider string
 }
 
 // MethodTemplate describes the structure of an RPC method.
 type MethodTemplate struct {
-	// Name is the name of the RPC method.
+	// Name is the name of the generated client method.
 	Name string
+	// RPCName is the name of the RPC method on the server (e.g. `Sum` in `Math.Sum`).
+	// It differs from Name when the method is renamed with `//glue:name`.
+	RPCName string
 	// ArgType is the name of the RPC argument type (e.g. `string`).
 	ArgType string
 	// ReplyType is the name of the RPC response type (e.g. `string`).
 	ReplyType string
+	// ArgPointer is set if the server method takes a pointer to ArgType.
+	ArgPointer bool
+	// ReplyMake is set if net/rpc allocates replies before calling the method:
+	// `map` for map replies and `slice` for slice ones.
+	ReplyMake string
+	// Deprecated is set by `//glue:deprecated`.
+	Deprecated bool
+	// DeprecationNote explains a deprecation (e.g. `use Sum2`).
+	DeprecationNote string
+	// Timeout is set by `//glue:timeout`. Zero means no timeout.
+	Timeout time.Duration
+	// Idempotent is set by `//glue:idempotent`.
+	Idempotent bool
 }
 
 type Import struct {
@@ -34,13 +65,47 @@ type Import struct {
 	Path string
 }
 
-func mustParseTemplate(path string) *template.Template {
-	data, err := Asset(path)
-	if err != nil {
-		panic(err)
+// mustParseTemplate parses the template at path, along with the templates it
+// uses defined in others.
+func mustParseTemplate(path string, others ...string) *template.Template {
+	t := template.New(path).Funcs(funcs)
+	for _, p := range append([]string{path}, others...) {
+		data, err := Asset(p)
+		if err != nil {
+			panic(err)
+		}
+
+		if p != path {
+			t = t.New(p)
+		}
+		template.Must(t.Parse(string(data)))
+	}
+
+	return t.Lookup(path)
+}
+
+var funcs = template.FuncMap{
+	"duration": durationLiteral,
+}
+
+// durationLiteral renders d as a Go expression (e.g. `2 * time.Second`).
+func durationLiteral(d time.Duration) string {
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	}
+
+	for _, u := range units {
+		if d%u.d == 0 {
+			return fmt.Sprintf("%d * %s", d/u.d, u.name)
+		}
 	}
 
-	return template.Must(
-		template.New(path).Parse(string(data)),
-	)
+	return fmt.Sprintf("%d * time.Nanosecond", d)
 }
@@ -0,0 +1,373 @@
+// Package typescript generates TypeScript clients for JSON-RPC services served
+// with the json codec of gorilla/rpc.
+package typescript
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/spec"
+)
+
+var tmpl = template.Must(
+	template.New("typescript").Funcs(template.FuncMap{
+		"jsdoc":    jsdoc,
+		"property": property,
+	}).Parse(string(generator.MustAsset("templates/typescript.tshtml"))),
+)
+
+// TemplateData structures input to the templates/typescript.tshtml template.
+type TemplateData struct {
+	// Header describes the provenance of the generated code.
+	Header generator.Header
+	// Identifier is the name of the client class, without its `Client` suffix.
+	Identifier string
+	// Doc is the doc comment of the service.
+	Doc string
+	// Types are the declarations of named types, in order of first use.
+	Types []Type
+	// Methods are the methods of the client class.
+	Methods []Method
+}
+
+// A Type declares a named type: an interface if it has Fields, or an alias of
+// Alias otherwise.
+type Type struct {
+	Name   string
+	Doc    string
+	Fields []Field
+	Alias  string
+}
+
+// A Field is a property of an interface.
+type Field struct {
+	// Name is the JSON name of the property.
+	Name     string
+	Type     string
+	Optional bool
+	Doc      string
+}
+
+// A Method is a method of the client class.
+type Method struct {
+	// Name is the lower camel case client name of the method (e.g. `sum`),
+	// suffixed with underscores if another member of the class has it (e.g.
+	// `constructor_`).
+	Name string
+	// RPC is the name the method calls (e.g. `Math.Sum`).
+	RPC       string
+	ArgType   string
+	ReplyType string
+	Doc       string
+	// Timeout is the timeout of calls in milliseconds. Zero means no timeout.
+	Timeout int64
+}
+
+// Generate renders a TypeScript module declaring the arg and reply types of
+// the service's methods, following encoding/json, and a client class calling
+// them.
+func Generate(in generator.GenerateInput) ([]byte, error) {
+	if err := generator.RequireGorilla(in.Service, "TypeScript clients"); err != nil {
+		return nil, err
+	}
+
+	data := TemplateData{
+		Header:     in.Header,
+		Identifier: in.Identifier,
+		Doc:        in.Service.Doc,
+	}
+	if data.Identifier == "" {
+		data.Identifier = in.Service.Name
+	}
+
+	c := newConverter(in.Service)
+	// Keep the names the module declares or references.
+	for _, name := range []string{data.Identifier + "Client", "Fetch", "RPCError", "Record", "Promise", "Error", "AbortSignal"} {
+		c.taken[name] = true
+	}
+
+	// Keep the members of the client class.
+	members := map[string]bool{}
+	for name := range classMembers {
+		members[name] = true
+	}
+
+	for _, m := range in.Methods {
+		name := lowerCamel(m.ClientName())
+		for members[name] {
+			name += "_"
+		}
+		members[name] = true
+
+		data.Methods = append(data.Methods, Method{
+			Name:      name,
+			RPC:       m.RPC,
+			ArgType:   c.typeOf(m.Arg),
+			ReplyType: c.typeOf(m.Reply),
+			Doc:       methodDoc(m),
+			Timeout:   int64((m.Annotations.Timeout + 999999) / 1000000),
+		})
+	}
+	data.Types = c.types
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, data); err != nil {
+		log.OrDefault(in.Logger).Error("failed to render template", log.Err(err))
+		return nil, err
+	}
+
+	return src.Bytes(), nil
+}
+
+func methodDoc(m spec.Method) string {
+	var lines []string
+	if m.Doc != "" {
+		lines = append(lines, m.Doc)
+	}
+	if m.Annotations.Idempotent {
+		lines = append(lines, "Idempotent: safe to retry.")
+	}
+	if m.Annotations.Deprecated {
+		lines = append(lines, strings.TrimSpace("@deprecated "+m.Annotations.DeprecationNote))
+	}
+
+	return strings.Join(lines, "\n\n")
+}
+
+// converter converts the types of a service into TypeScript types. Named types
+// are declared once and referenced by name.
+type converter struct {
+	svc   *spec.Service
+	types []Type
+	// names are the TypeScript names of named types by ID.
+	names map[string]string
+	taken map[string]bool
+}
+
+func newConverter(svc *spec.Service) *converter {
+	return &converter{
+		svc:   svc,
+		names: map[string]string{},
+		taken: map[string]bool{},
+	}
+}
+
+// typeOf converts t into a TypeScript type matching what encoding/json
+// encodes it as.
+func (c *converter) typeOf(t spec.TypeRef) string {
+	switch t.Kind {
+	case spec.KindBasic:
+		return basic(t.Name)
+	case spec.KindNamed:
+		return c.named(t)
+	case spec.KindPointer:
+		return nullable(c.typeOf(*t.Elem))
+	case spec.KindSlice:
+		// encoding/json encodes []byte as base64, and nil slices as null.
+		if e := *t.Elem; e.Kind == spec.KindBasic && (e.Name == "byte" || e.Name == "uint8") {
+			return "string | null"
+		}
+		return nullable(c.elem(*t.Elem) + "[]")
+	case spec.KindArray:
+		return c.elem(*t.Elem) + "[]"
+	case spec.KindMap:
+		// Nil maps are encoded as null.
+		return nullable("Record<string, " + c.typeOf(*t.Elem) + ">")
+	case spec.KindStruct:
+		fields := c.fields(t.Struct)
+		if len(fields) == 0 {
+			return "{}"
+		}
+
+		props := make([]string, 0, len(fields))
+		for _, f := range fields {
+			prop := property(f.Name)
+			if f.Optional {
+				prop += "?"
+			}
+			props = append(props, prop+": "+f.Type)
+		}
+		return "{ " + strings.Join(props, "; ") + " }"
+	}
+
+	// Interfaces can hold anything.
+	return "unknown"
+}
+
+// elem converts the element type of an array, parenthesizing unions.
+func (c *converter) elem(t spec.TypeRef) string {
+	s := c.typeOf(t)
+	if strings.Contains(s, " | ") {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+func (c *converter) named(t spec.TypeRef) string {
+	switch t.ID() {
+	case "time.Time":
+		return "string"
+	case "encoding/json.RawMessage", "encoding/json/jsontext.Value":
+		return "unknown"
+	case "encoding/json.Number":
+		return "number"
+	}
+
+	n := c.svc.Lookup(t)
+	if n == nil {
+		// Predeclared types (i.e. error).
+		return "unknown"
+	}
+
+	if n.Implements(spec.MarshalerJSON) {
+		return "unknown"
+	}
+	if n.Implements(spec.MarshalerText) {
+		return "string"
+	}
+
+	name, ok := c.names[t.ID()]
+	if ok {
+		return name
+	}
+
+	name = c.declName(n)
+	c.names[t.ID()] = name
+	c.taken[name] = true
+
+	// Reserve the declaration before converting it so recursive types
+	// reference it.
+	i := len(c.types)
+	c.types = append(c.types, Type{Name: name, Doc: n.Doc})
+	if n.Struct != nil {
+		fields := c.fields(n.Struct)
+		if len(fields) == 0 {
+			c.types[i].Alias = "Record<string, never>"
+		}
+		c.types[i].Fields = fields
+	} else {
+		c.types[i].Alias = c.typeOf(*n.Underlying)
+	}
+
+	return name
+}
+
+func (c *converter) declName(n *spec.Named) string {
+	name := n.Ident()
+	if !c.taken[name] {
+		return name
+	}
+
+	// Packages of the same name are numbered.
+	name = n.PackageName + "_" + name
+	base := name
+	for i := 2; c.taken[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	return name
+}
+
+func (c *converter) fields(st *spec.Struct) []Field {
+	var ret []Field
+	for _, p := range c.svc.JSONProperties(st) {
+		f := Field{
+			Name:     p.Name,
+			Type:     c.typeOf(p.Field.Type),
+			Optional: p.Optional,
+			Doc:      p.Field.Doc,
+		}
+		if p.String {
+			f.Type = "string"
+			if p.Field.Type.Kind == spec.KindPointer {
+				f.Type = nullable(f.Type)
+			}
+		}
+
+		ret = append(ret, f)
+	}
+
+	return ret
+}
+
+func basic(name string) string {
+	switch name {
+	case "bool":
+		return "boolean"
+	case "string":
+		return "string"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"byte", "rune", "float32", "float64":
+		return "number"
+	}
+
+	// e.g. complex numbers, which encoding/json can't encode.
+	return "unknown"
+}
+
+// nullable allows t to be null, as nil pointers, slices and maps are encoded.
+func nullable(t string) string {
+	if t == "unknown" || strings.HasSuffix(t, " | null") {
+		return t
+	}
+	return t + " | null"
+}
+
+// classMembers are the members of the client class other than its methods.
+// `constructor` can't name a method either.
+var classMembers = map[string]bool{
+	"constructor": true, "_id": true, "_url": true, "_fetch": true, "_call": true,
+}
+
+// lowerCamel lowers the leading upper case letters of a Go identifier,
+// keeping the last one of an initialism that starts a word (e.g. `URLFor`
+// becomes `urlFor`).
+func lowerCamel(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+// property quotes a property name unless it's a valid identifier.
+func property(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return strconv.Quote(name)
+	}
+	if name == "" {
+		return `""`
+	}
+	return name
+}
+
+// jsdoc renders doc as a JSDoc comment indented by indent, followed by a
+// newline, or nothing if doc is empty.
+func jsdoc(indent, doc string) string {
+	if doc == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(indent + "/**\n")
+	for _, line := range strings.Split(strings.Replace(doc, "*/", "*\\/", -1), "\n") {
+		b.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	b.WriteString(indent + " */\n")
+
+	return b.String()
+}
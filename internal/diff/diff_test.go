@@ -0,0 +1,85 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/segmentio/glue/internal/diff"
+)
+
+// numbered returns the lines 1 through n, with line i replaced by its
+// replacement in edits.
+func numbered(n int, edits map[int]string) string {
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		line, ok := edits[i]
+		if !ok {
+			line = strings.Repeat("x", i)
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{
+			"changed line",
+			"a\nb\nc\n",
+			"a\nB\nc\n",
+			"--- a\n+++ b\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			"created",
+			"",
+			"x\ny\n",
+			"--- a\n+++ b\n@@ -0,0 +1,2 @@\n+x\n+y\n",
+		},
+		{
+			"deleted",
+			"x\n",
+			"",
+			"--- a\n+++ b\n@@ -1,1 +0,0 @@\n-x\n",
+		},
+		{
+			"no newline at end of file",
+			"a\n",
+			"a",
+			"--- a\n+++ b\n@@ -1,1 +1,1 @@\n-a\n+a\n\\ No newline at end of file\n",
+		},
+		{
+			"context",
+			numbered(9, nil),
+			numbered(9, map[int]string{5: "five"}),
+			"--- a\n+++ b\n@@ -2,7 +2,7 @@\n xx\n xxx\n xxxx\n-xxxxx\n+five\n xxxxxx\n xxxxxxx\n xxxxxxxx\n",
+		},
+		{
+			// Changes separated by more than twice the context are in
+			// separate hunks.
+			"hunks",
+			numbered(10, nil),
+			numbered(10, map[int]string{1: "one", 10: "ten"}),
+			"--- a\n+++ b\n" +
+				"@@ -1,4 +1,4 @@\n-x\n+one\n xx\n xxx\n xxxx\n" +
+				"@@ -7,4 +7,4 @@\n xxxxxxx\n xxxxxxxx\n xxxxxxxxx\n-xxxxxxxxxx\n+ten\n",
+		},
+		{
+			// Closer changes share a hunk.
+			"merged hunks",
+			numbered(8, nil),
+			numbered(8, map[int]string{1: "one", 8: "eight"}),
+			"--- a\n+++ b\n" +
+				"@@ -1,8 +1,8 @@\n-x\n+one\n xx\n xxx\n xxxx\n xxxxx\n xxxxxx\n xxxxxxx\n-xxxxxxxx\n+eight\n",
+		},
+	}
+	for _, test := range tests {
+		if got := diff.Unified("a", "b", []byte(test.a), []byte(test.b)); got != test.want {
+			t.Errorf("%s: Unified =\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
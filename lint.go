@@ -0,0 +1,68 @@
+package glue
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/lint"
+)
+
+// Lint walks the source code like Walk, but instead of generating code it
+// checks the target declaration with the rules selected by cfg. Findings are
+// sent to the Reporter as warnings. Only Path and Name of directions are used.
+func (w *Walker) Lint(directions Directions, cfg lint.Config) error {
+	w = w.rooted(directions)
+
+	if _, err := cfg.Rules(); err != nil {
+		w.invalidDirections(err)
+		return err
+	}
+
+	prgm, err := w.load(directions.Path)
+	if err != nil {
+		return err
+	}
+
+	var found bool
+	for _, pkg := range prgm.InitialPackages() {
+		// Lint rules report unsuitable methods themselves.
+		visitor := NewVisitor(VisitorConfig{
+			Pkg:         pkg,
+			Provider:    w.Provider,
+			Declaration: directions.Name,
+			Logger:      w.Logger,
+		})
+		visitor.Go()
+
+		decl := visitor.Declaration()
+		if decl == nil {
+			continue
+		}
+		found = true
+
+		err := lint.Run(lint.Target{
+			Fset:        prgm.Fset,
+			Files:       pkg.Files,
+			Info:        &pkg.Info,
+			Provider:    w.Provider,
+			Declaration: decl,
+		}, cfg, w.Reporter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		w.logger().Error("could not find RPC declaration")
+		w.reporter().Report(diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Rule:     "declaration-not-found",
+			Message:  fmt.Sprintf("could not find RPC declaration %s in %s", directions.Name, directions.Path),
+			File:     localDir(directions.Path),
+		})
+		return errors.New("not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Package lint checks RPC service declarations for hygiene problems that
+// don't stop glue from generating clients, but are likely bugs or make the
+// service harder to evolve.
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/provider"
+)
+
+// DefaultMaxArgSize is the default Config.MaxArgSize in bytes.
+const DefaultMaxArgSize = 256
+
+// A Rule checks one aspect of an RPC service.
+type Rule struct {
+	// Name identifies the rule in diagnostics and in Config (e.g. `large-arg`).
+	Name string
+	// Doc is a one-line description of what the rule reports.
+	Doc string
+	// Check reports findings through Pass.Reportf.
+	Check func(*Pass)
+}
+
+// Rules are all the rules, in the order they run.
+var Rules = []*Rule{
+	ReplyNotStructPointer,
+	LargeArg,
+	UnsuitableMethod,
+	DuplicateMethod,
+	SharedType,
+	ReplyNotZeroed,
+}
+
+// Lookup returns the rule with the supplied name, or nil if there's none.
+func Lookup(name string) *Rule {
+	for _, r := range Rules {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+// Config selects the rules to run and tunes them.
+type Config struct {
+	// Enable lists the rules to run. All rules run if it's empty.
+	Enable []string
+	// Disable lists rules not to run. It takes precedence over Enable.
+	Disable []string
+	// MaxArgSize is the size in bytes above which args passed by value are
+	// reported by LargeArg. It defaults to DefaultMaxArgSize.
+	MaxArgSize int64
+}
+
+// Rules returns the rules selected by Enable and Disable, or an error if
+// either names an unknown rule.
+func (c Config) Rules() ([]*Rule, error) {
+	for _, name := range append(append([]string(nil), c.Enable...), c.Disable...) {
+		if Lookup(name) == nil {
+			return nil, fmt.Errorf("unknown lint rule %q", name)
+		}
+	}
+
+	var ret []*Rule
+	for _, r := range Rules {
+		if len(c.Enable) > 0 && !contains(c.Enable, r.Name) {
+			continue
+		}
+		if contains(c.Disable, r.Name) {
+			continue
+		}
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// A Target is an RPC declaration to lint.
+type Target struct {
+	Fset *token.FileSet
+	// Files and Info are the syntax and type information of the package
+	// declaring the target.
+	Files []*ast.File
+	Info  *types.Info
+	// Provider determines which methods are RPC methods and their arg and reply types.
+	Provider provider.Provider
+	// Declaration is the RPC declaration (e.g. `type Service struct{}`).
+	Declaration *types.Named
+}
+
+// Run checks the target with the rules selected by cfg and sends findings to
+// reporter as warnings.
+func Run(target Target, cfg Config, reporter diagnostic.Reporter) error {
+	rules, err := cfg.Rules()
+	if err != nil {
+		return err
+	}
+
+	pass := &Pass{
+		Target:     target,
+		MaxArgSize: cfg.MaxArgSize,
+		reporter:   diagnostic.OrDiscard(reporter),
+	}
+	if pass.MaxArgSize <= 0 {
+		pass.MaxArgSize = DefaultMaxArgSize
+	}
+
+	decl := target.Declaration
+	for i := 0; i < decl.NumMethods(); i++ {
+		if m := decl.Method(i); target.Provider.IsSuitableMethod(m) {
+			pass.Methods = append(pass.Methods, m)
+		}
+	}
+
+	for _, r := range rules {
+		pass.rule = r
+		r.Check(pass)
+	}
+
+	return nil
+}
+
+// A Pass is the state a Rule checks.
+type Pass struct {
+	Target
+	// Methods are the declaration's methods the Provider considers RPC methods.
+	Methods []*types.Func
+	// MaxArgSize is Config.MaxArgSize, defaulted.
+	MaxArgSize int64
+
+	rule     *Rule
+	reporter diagnostic.Reporter
+}
+
+// Reportf reports a finding of the running rule at pos.
+func (p *Pass) Reportf(pos token.Pos, format string, args ...interface{}) {
+	p.reporter.Report(diagnostic.At(p.Fset.Position(pos), diagnostic.SeverityWarning,
+		p.rule.Name, fmt.Sprintf(format, args...)))
+}
+
+// FuncDecl returns the declaration of a method, or nil if it isn't declared
+// in the target's files.
+func (p *Pass) FuncDecl(method *types.Func) *ast.FuncDecl {
+	for _, file := range p.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if ok && fd.Recv != nil && p.Info.Defs[fd.Name] == method {
+				return fd
+			}
+		}
+	}
+	return nil
+}
+
+// Params returns the arg and reply parameters of an RPC method. Unlike
+// Provider.GetArgType and GetReplyType, their types aren't dereferenced.
+// Both net/rpc and gorilla/rpc methods take them as their last two parameters.
+func (p *Pass) Params(method *types.Func) (arg, reply *types.Var) {
+	params := method.Type().(*types.Signature).Params()
+	if params.Len() < 2 {
+		return nil, nil
+	}
+	return params.At(params.Len() - 2), params.At(params.Len() - 1)
+}
+
+// typeName formats t qualified by package name (e.g. `math.SumArg`).
+func typeName(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
+
+// list joins names as "a, b and c".
+func list(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
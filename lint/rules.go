@@ -0,0 +1,364 @@
+package lint
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/segmentio/glue/provider"
+)
+
+// ReplyNotStructPointer reports replies that aren't pointers to structs.
+// Struct replies can gain fields without breaking existing clients.
+var ReplyNotStructPointer = &Rule{
+	Name: "reply-not-struct-pointer",
+	Doc:  "reply type is not a pointer to a struct",
+	Check: func(p *Pass) {
+		for _, m := range p.Methods {
+			_, param := p.Params(m)
+			reply := param.Type()
+			if ptr, ok := reply.(*types.Pointer); ok {
+				if _, ok := ptr.Elem().Underlying().(*types.Struct); ok {
+					continue
+				}
+			}
+
+			p.Reportf(m.Pos(), "reply type of %s is %s, not a pointer to a struct, so it can't gain fields without breaking clients",
+				m.Name(), typeName(reply))
+		}
+	},
+}
+
+// sizes are those of the most common target; they only need to be roughly right.
+var sizes = types.SizesFor("gc", "amd64")
+
+// LargeArg reports structs and arrays larger than Pass.MaxArgSize passed by value.
+var LargeArg = &Rule{
+	Name: "large-arg",
+	Doc:  "arg is a large struct or array passed by value",
+	Check: func(p *Pass) {
+		for _, m := range p.Methods {
+			param, _ := p.Params(m)
+			arg := param.Type()
+			switch arg.Underlying().(type) {
+			case *types.Struct, *types.Array:
+			default:
+				continue
+			}
+
+			if size := sizes.Sizeof(arg); size > p.MaxArgSize {
+				p.Reportf(m.Pos(), "%s takes %s by value, which copies %d bytes per call; take a pointer instead",
+					m.Name(), typeName(arg), size)
+			}
+		}
+	},
+}
+
+// UnsuitableMethod reports exported methods with parameters that the Provider
+// doesn't consider RPC methods. They're likely RPC methods with a typo in
+// their signature. Methods without parameters (e.g. `Close() error`) are
+// assumed to be helpers.
+var UnsuitableMethod = &Rule{
+	Name: "unsuitable-method",
+	Doc:  "exported method with parameters is not a suitable RPC method",
+	Check: func(p *Pass) {
+		decl := p.Declaration
+		for i := 0; i < decl.NumMethods(); i++ {
+			m := decl.Method(i)
+			if !m.Exported() || m.Type().(*types.Signature).Params().Len() == 0 {
+				continue
+			}
+			if p.Provider.IsSuitableMethod(m) {
+				continue
+			}
+
+			reason := "rejected by " + p.Provider.Name()
+			if explainer, ok := p.Provider.(provider.Explainer); ok {
+				if r := explainer.Explain(m); r != nil {
+					reason = r.Reason
+				}
+			}
+
+			p.Reportf(m.Pos(), "exported method %s is not an RPC method (%s); unexport it if that's intended", m.Name(), reason)
+		}
+	},
+}
+
+// DuplicateMethod reports exported methods provided by several embedded
+// types, which Go silently leaves out of the method set, and methods of the
+// declaration that shadow a method of an embedded type.
+var DuplicateMethod = &Rule{
+	Name: "duplicate-method",
+	Doc:  "method name is provided by several embedded types",
+	Check: func(p *Pass) {
+		decl := p.Declaration
+		st, ok := decl.Underlying().(*types.Struct)
+		if !ok {
+			return
+		}
+
+		providers := map[string][]string{}
+		var names []string
+		for i := 0; i < st.NumFields(); i++ {
+			f := st.Field(i)
+			if !f.Embedded() {
+				continue
+			}
+
+			t := f.Type()
+			if _, ok := t.(*types.Pointer); !ok && !types.IsInterface(t) {
+				t = types.NewPointer(t)
+			}
+
+			ms := types.NewMethodSet(t)
+			for j := 0; j < ms.Len(); j++ {
+				name := ms.At(j).Obj().Name()
+				if !ms.At(j).Obj().Exported() {
+					continue
+				}
+
+				if providers[name] == nil {
+					names = append(names, name)
+				}
+				providers[name] = append(providers[name], f.Name())
+			}
+		}
+
+		for _, name := range names {
+			embedded := providers[name]
+			if own := declaredMethod(decl, name); own != nil {
+				p.Reportf(own.Pos(), "%s.%s shadows the %s method of embedded %s",
+					decl.Obj().Name(), name, name, list(embedded))
+				continue
+			}
+
+			if len(embedded) > 1 {
+				p.Reportf(decl.Obj().Pos(), "method %s is provided by embedded %s, so it's ambiguous and not promoted to %s",
+					name, list(embedded), decl.Obj().Name())
+			}
+		}
+	},
+}
+
+func declaredMethod(named *types.Named, name string) *types.Func {
+	for i := 0; i < named.NumMethods(); i++ {
+		if m := named.Method(i); m.Name() == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// SharedType reports named arg or reply types used by several methods, which
+// couples the methods: changing the type for one changes it for all.
+var SharedType = &Rule{
+	Name: "shared-type",
+	Doc:  "arg or reply type is shared by several methods",
+	Check: func(p *Pass) {
+		users := map[*types.TypeName][]*types.Func{}
+		var order []*types.TypeName
+		for _, m := range p.Methods {
+			seen := map[*types.TypeName]bool{}
+			for _, t := range []types.Type{p.Provider.GetArgType(m), p.Provider.GetReplyType(m)} {
+				obj := namedObj(t)
+				if obj == nil || obj.Pkg() == nil || seen[obj] {
+					continue
+				}
+				seen[obj] = true
+
+				if users[obj] == nil {
+					order = append(order, obj)
+				}
+				users[obj] = append(users[obj], m)
+			}
+		}
+
+		for _, obj := range order {
+			methods := users[obj]
+			for _, m := range methods[1:] {
+				p.Reportf(m.Pos(), "%s shares %s with %s; give each method its own arg and reply types so they can change independently",
+					m.Name(), typeName(obj.Type()), methods[0].Name())
+			}
+		}
+	},
+}
+
+// namedObj returns the type name of t, or of what t points to.
+func namedObj(t types.Type) *types.TypeName {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok {
+		return named.Obj()
+	}
+	return nil
+}
+
+// ReplyNotZeroed reports methods that read the reply (e.g. `reply.Sum += v`)
+// before assigning it as a whole (e.g. `*reply = SumReply{}`) or setting the
+// field, so the reply depends on what the caller passed in. Statements are
+// considered in source order, regardless of control flow. Passing the reply or
+// a field's address elsewhere ends the check.
+var ReplyNotZeroed = &Rule{
+	Name: "reply-not-zeroed",
+	Doc:  "reply is read before it's assigned",
+	Check: func(p *Pass) {
+		for _, m := range p.Methods {
+			fd := p.FuncDecl(m)
+			if fd == nil || fd.Body == nil {
+				continue
+			}
+
+			reply := replyParam(p, m)
+			if reply == nil {
+				continue
+			}
+
+			u := &replyUses{info: p.Info, reply: reply, set: map[string]bool{}}
+			u.walk(fd.Body)
+			if u.read == nil {
+				continue
+			}
+
+			if u.field == "" {
+				p.Reportf(u.read.Pos(), "%s reads *%s before assigning it, so the reply depends on what the caller passed in",
+					m.Name(), reply.Name())
+			} else {
+				p.Reportf(u.read.Pos(), "%s reads %s.%s before setting it; assign *%s first so the reply doesn't depend on what the caller passed in",
+					m.Name(), reply.Name(), u.field, reply.Name())
+			}
+		}
+	},
+}
+
+// replyParam returns the reply parameter of a method, or nil if it's unnamed
+// or not a pointer.
+func replyParam(p *Pass, m *types.Func) *types.Var {
+	_, reply := p.Params(m)
+	if _, ok := reply.Type().(*types.Pointer); !ok {
+		return nil
+	}
+	if reply.Name() == "" || reply.Name() == "_" {
+		return nil
+	}
+	return reply
+}
+
+// replyUses finds the first read of a reply that isn't preceded by an
+// assignment.
+type replyUses struct {
+	info  *types.Info
+	reply *types.Var
+	// set are the fields assigned so far.
+	set map[string]bool
+	// done is set once the reply is assigned as a whole or escapes.
+	done bool
+
+	// read is the first offending read, of field or, if empty, of the whole reply.
+	read  ast.Node
+	field string
+}
+
+func (u *replyUses) walk(node ast.Node) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if u.done || n == nil {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Closures may run at any time.
+			return false
+		case *ast.AssignStmt:
+			for _, rhs := range n.Rhs {
+				u.walk(rhs)
+			}
+			for _, lhs := range n.Lhs {
+				u.assign(lhs, n.Tok)
+			}
+			return false
+		case *ast.UnaryExpr:
+			if _, _, ok := u.target(n.X); ok && n.Op == token.AND {
+				u.done = true
+				return false
+			}
+		case ast.Expr:
+			field, whole, ok := u.target(n)
+			if !ok {
+				break
+			}
+			if field == "" && !whole {
+				// The reply escapes (e.g. it's passed to a function).
+				u.done = true
+			} else if whole || !u.set[field] {
+				u.read, u.field, u.done = n, field, true
+			}
+			return false
+		}
+
+		return true
+	})
+}
+
+func (u *replyUses) assign(lhs ast.Expr, tok token.Token) {
+	if u.done {
+		return
+	}
+
+	if tok == token.ASSIGN {
+		field, whole, ok := u.target(lhs)
+		switch {
+		case ok && whole:
+			u.done = true
+			return
+		case ok && field != "":
+			u.set[field] = true
+			return
+		}
+	}
+
+	// Compound assignments (e.g. +=) and assignments to elements read the
+	// operand first.
+	u.walk(lhs)
+}
+
+// target reports whether e refers to the reply: as a whole (`*reply`), to one
+// of its fields (`reply.F` or `(*reply).F`), or otherwise (e.g. `reply` or
+// `reply.Method`), in which case both field and whole are empty.
+func (u *replyUses) target(e ast.Expr) (field string, whole bool, ok bool) {
+	switch e := unparen(e).(type) {
+	case *ast.Ident:
+		return "", false, u.info.Uses[e] == u.reply
+	case *ast.StarExpr:
+		if id, ok := unparen(e.X).(*ast.Ident); ok && u.info.Uses[id] == u.reply {
+			return "", true, true
+		}
+	case *ast.SelectorExpr:
+		x := unparen(e.X)
+		if star, ok := x.(*ast.StarExpr); ok {
+			x = unparen(star.X)
+		}
+
+		id, ok := x.(*ast.Ident)
+		if !ok || u.info.Uses[id] != u.reply {
+			return "", false, false
+		}
+
+		if sel := u.info.Selections[e]; sel != nil && sel.Kind() == types.FieldVal {
+			return e.Sel.Name, false, true
+		}
+		return "", false, true
+	}
+
+	return "", false, false
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
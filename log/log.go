@@ -1,25 +1,83 @@
+// Package log builds the structured loggers glue components accept.
+//
+// Walker, Visitor, providers, the generator and writers all take an optional
+// *slog.Logger; when it's nil they fall back to Default, which writes text to
+// stderr so stdout stays free for generated output (e.g. `-print`).
 package log
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
 
-var DebugMode bool
+// Keys of the structured fields attached to log records.
+const (
+	KeyPackage     = "package"
+	KeyDeclaration = "declaration"
+	KeyMethod      = "method"
+	KeyClient      = "client"
+	KeyFile        = "file"
+	KeyRule        = "rule"
+	KeyReason      = "reason"
+	KeyError       = "error"
+)
 
-func Print(v ...interface{}) {
-	fmt.Println(v...)
+// Formats supported by New.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+var defaultLogger = slog.New(newHandler(os.Stderr, FormatText, slog.LevelInfo))
+
+// New creates a logger that writes records at or above level to w in format
+// (FormatText or FormatJSON).
+func New(w io.Writer, format string, level slog.Level) (*slog.Logger, error) {
+	if format != FormatText && format != FormatJSON {
+		return nil, fmt.Errorf("unknown log format %q", format)
+	}
+
+	return slog.New(newHandler(w, format, level)), nil
 }
 
-func Printf(format string, v ...interface{}) {
-	fmt.Printf(format+"\n", v...)
+// Default returns the logger used by glue components that aren't given one.
+func Default() *slog.Logger {
+	return defaultLogger
 }
 
-func Debug(v ...interface{}) {
-	if DebugMode {
-		Print(v...)
+// OrDefault returns l, or Default if l is nil.
+func OrDefault(l *slog.Logger) *slog.Logger {
+	if l == nil {
+		return defaultLogger
 	}
+	return l
+}
+
+// Discard returns a logger that drops every record.
+func Discard() *slog.Logger {
+	return slog.New(newHandler(io.Discard, FormatText, slog.LevelError+1))
 }
 
-func Debugf(format string, v ...interface{}) {
-	if DebugMode {
-		Printf(format, v...)
+// Err formats an error as a structured field.
+func Err(err error) slog.Attr {
+	return slog.String(KeyError, err.Error())
+}
+
+func newHandler(w io.Writer, format string, level slog.Level) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level}
+	if format == FormatJSON {
+		return slog.NewJSONHandler(w, opts)
 	}
+
+	// Timestamps are noise for a code generator run from a terminal.
+	opts.ReplaceAttr = func(groups []string, a slog.Attr) slog.Attr {
+		if len(groups) == 0 && a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return a
+	}
+
+	return slog.NewTextHandler(w, opts)
 }
@@ -0,0 +1,98 @@
+// Package manifest describes the files glue generated in an output directory.
+//
+// When Directions.Manifest is set, Walker records every file it generates in
+// the manifest of the output, which it reads back to remove files it no longer
+// generates. -check compares it like generated code, and tooling can consume
+// it to know what glue generated and from where.
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+// Manifest lists every file generated in an output directory.
+type Manifest struct {
+	// Version is the version of glue that generated the files, if it was
+	// recorded.
+	Version string `json:"version,omitempty"`
+	// Files are the generated files, sorted by path.
+	Files []File `json:"files"`
+}
+
+// File describes a generated file.
+type File struct {
+	// Path is the path of the file relative to the output directory.
+	Path string `json:"path"`
+	// Lockfile is the path of the lockfile of the file, if its format has one.
+	Lockfile string `json:"lockfile,omitempty"`
+	// Owner identifies the declaration, format and filename template the file
+	// was generated for, so that files they no longer generate can be removed.
+	Owner string `json:"owner"`
+	// Package is the name of the generated package (e.g. `client`).
+	Package string `json:"package"`
+	// Source is the import path of the server package.
+	Source string `json:"source"`
+	// Declaration is the name of the RPC declaration (e.g. `Service`).
+	Declaration string `json:"declaration"`
+	// Service is the name of the RPC service (e.g. `Math`).
+	Service string `json:"service"`
+	// Client is the name of the generated client type (e.g. `MathAdmin`).
+	Client string `json:"client"`
+	// Provider is the name of the provider that selected the methods (e.g. `stl`).
+	Provider string `json:"provider"`
+	// Methods are the RPC methods of the client.
+	Methods []Method `json:"methods"`
+	// Hash is the SHA-256 of the file content, formatted as `sha256:<hex>`.
+	Hash string `json:"hash"`
+}
+
+// Method describes an RPC method of a generated client.
+type Method struct {
+	// Name is the name of the client method (e.g. `SumAll`).
+	Name string `json:"name"`
+	// RPC is the name of the RPC method on the wire (e.g. `Math.Sum`).
+	RPC string `json:"rpc"`
+}
+
+// Paths returns the path of the file, and of its lockfile if it has one.
+func (f File) Paths() []string {
+	if f.Lockfile == "" {
+		return []string{f.Path}
+	}
+	return []string{f.Path, f.Lockfile}
+}
+
+// Hash returns the content hash recorded in File.Hash.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Encode renders the manifest as indented JSON. Files are sorted by path so
+// the output is stable across runs.
+func (m *Manifest) Encode() ([]byte, error) {
+	sort.Slice(m.Files, func(i, j int) bool {
+		return m.Files[i].Path < m.Files[j].Path
+	})
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// Read decodes a manifest.
+func Read(r io.Reader) (*Manifest, error) {
+	var m Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
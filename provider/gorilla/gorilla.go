@@ -19,12 +19,35 @@ func New(base provider.Provider) *Provider {
 	return &Provider{BaseProvider: base}
 }
 
+// Name identifies the provider.
+func (p *Provider) Name() string {
+	return "gorilla"
+}
+
 // IsSuitableMethod determines if a receiver method is structured as a gorilla/rpc method.
 func (p *Provider) IsSuitableMethod(method *types.Func) bool {
 	newMethod := p.shiftReqParam(method)
 	return p.BaseProvider.IsSuitableMethod(newMethod)
 }
 
+// Explain proxies the base provider's Explain with a shifted function. Providers
+// that can't explain their verdicts yield a generic rejection.
+func (p *Provider) Explain(method *types.Func) *provider.Rejection {
+	newMethod := p.shiftReqParam(method)
+	if explainer, ok := p.BaseProvider.(provider.Explainer); ok {
+		return explainer.Explain(newMethod)
+	}
+
+	if !p.BaseProvider.IsSuitableMethod(newMethod) {
+		return &provider.Rejection{
+			Rule:   "unsuitable",
+			Reason: "rejected by " + p.BaseProvider.Name(),
+		}
+	}
+
+	return nil
+}
+
 // GetArgType proxies stl.GetArgType with a shifted function.
 func (p *Provider) GetArgType(f *types.Func) types.Type {
 	newMethod := p.shiftReqParam(f)
@@ -2,54 +2,43 @@ package internal
 
 import (
 	"go/types"
+	"strings"
 )
 
 // IsExportedOrBuiltin returns true if a type is either exported or primitive.
-//
-// Note(tejasmanohar): If the type is a map, both the key and value must be exported
-// since they're both necessary to represent the type.
-func IsExportedOrBuiltin(_t types.Type) bool {
-	for _, t := range unpack(_t) {
-		if _, isPrimitive := t.(*types.Basic); isPrimitive {
-			return true
-		}
+func IsExportedOrBuiltin(t types.Type) bool {
+	return UnexportedPath(t) == ""
+}
 
-		namedType, ok := t.(*types.Named)
-		if !ok {
-			return false
-		}
+// UnexportedPath returns the path from t to the named type it points to if
+// that's neither exported nor builtin (e.g. `**foo -> *foo -> foo`), or an
+// empty string otherwise. Like net/rpc, only pointers are unpacked: unnamed
+// types such as slices and maps count as builtin, whatever their elements.
+func UnexportedPath(t types.Type) string {
+	path := unexportedPath(t, nil)
+	return strings.Join(path, " -> ")
+}
 
-		obj := namedType.Obj()
-		if obj == nil {
-			return false
-		}
+func unexportedPath(t types.Type, path []string) []string {
+	path = append(path, types.TypeString(t, packageName))
 
-		if !obj.Exported() {
-			return false
+	switch t := t.(type) {
+	case *types.Named:
+		obj := t.Obj()
+		// Builtin named types (e.g. error) have no package.
+		if obj == nil || obj.Pkg() == nil || obj.Exported() {
+			return nil
 		}
+		return path
+	case *types.Pointer:
+		return unexportedPath(t.Elem(), path)
 	}
 
-	return true
+	return nil
 }
 
-// unpack unpacks the most basic, underlying types from pointers, slices, maps, etc.
-// There can be multiple types in the case of maps (key and value).
-func unpack(t types.Type) []types.Type {
-	ret := make([]types.Type, 0, 1)
-
-	if ptr, ok := t.(*types.Pointer); ok {
-		ret = append(ret, unpack(ptr.Elem())...)
-	}
-
-	if slice, ok := t.(*types.Slice); ok {
-		ret = append(ret, unpack(slice.Elem())...)
-	}
-
-	if array, ok := t.(*types.Array); ok {
-		ret = append(ret, unpack(array.Elem())...)
-	}
-
-	return ret
+func packageName(pkg *types.Package) string {
+	return pkg.Name()
 }
 
 // Dereference dereferences pointers as needed.
@@ -6,7 +6,24 @@ import (
 
 // A Provider surfaces RPC-implementation-specific details.
 type Provider interface {
+	// Name identifies the provider (e.g. `stl`).
+	Name() string
 	IsSuitableMethod(*types.Func) bool
 	GetArgType(*types.Func) types.Type
 	GetReplyType(*types.Func) types.Type
 }
+
+// An Explainer is a Provider that can explain why a method is unsuitable.
+type Explainer interface {
+	Provider
+	// Explain returns why a method is unsuitable, or nil if it's suitable.
+	Explain(*types.Func) *Rejection
+}
+
+// A Rejection explains why a method is unsuitable.
+type Rejection struct {
+	// Rule identifies the failed check (e.g. `reply-not-pointer`).
+	Rule string
+	// Reason describes the failure in detail.
+	Reason string
+}
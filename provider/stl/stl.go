@@ -1,61 +1,90 @@
 package stl
 
 import (
+	"fmt"
 	"go/types"
+	"log/slog"
 
 	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/provider"
 	"github.com/segmentio/glue/provider/internal"
 )
 
 // Provider is a Glue provider for net/rpc.
-type Provider struct{}
+type Provider struct {
+	// Logger receives a debug record for every method found unsuitable.
+	// It defaults to log.Default.
+	Logger *slog.Logger
+}
+
+// Name identifies the provider.
+func (p *Provider) Name() string {
+	return "stl"
+}
 
 // IsSuitableMethod determines if a receiver method is structured as a net/rpc method.
 // The criteria is net/rpc.suitableMethods ported from reflect to types.Type.
 // https://github.com/golang/go/blob/release-branch.go1.8/src/net/rpc/server.go#L292
 func (p *Provider) IsSuitableMethod(method *types.Func) bool {
-	if !method.Exported() {
-		log.Debugf("skipping %s: unexported", method.Name())
+	rejection := p.Explain(method)
+	if rejection != nil {
+		log.OrDefault(p.Logger).Debug("skipping method",
+			slog.String(log.KeyMethod, method.Name()),
+			slog.String(log.KeyRule, rejection.Rule),
+			slog.String(log.KeyReason, rejection.Reason))
 		return false
 	}
 
+	// Methods that don't return an error are accepted, but worth a note.
+	if result := method.Type().(*types.Signature).Results().At(0); result.Type().String() != "error" {
+		log.OrDefault(p.Logger).Debug("unexpected result type",
+			slog.String(log.KeyMethod, method.Name()),
+			slog.String(log.KeyReason, fmt.Sprintf("expected func to return `error`, found %s", result.Type())))
+	}
+
+	return true
+}
+
+// Explain returns which net/rpc criteria a method fails, or nil if it's suitable.
+func (p *Provider) Explain(method *types.Func) *provider.Rejection {
+	if !method.Exported() {
+		return reject("method-unexported", "method is not exported")
+	}
+
 	signature := method.Type().(*types.Signature)
 	params := signature.Params()
 
 	if params.Len() != 2 {
-		log.Debugf("skipping %s: expected 2 params, found %d", method.Name(), params.Len())
-		return false
+		return reject("param-count", "expected 2 params, found %d", params.Len())
 	}
 
 	arg := params.At(0)
-	if !internal.IsExportedOrBuiltin(arg.Type()) {
-		log.Debugf("skipping %s: argument parameter's type %s is not exported", method.Name(), arg.Type())
-		return false
+	if path := internal.UnexportedPath(arg.Type()); path != "" {
+		return reject("arg-unexported", "argument parameter's type is not exported: %s", path)
 	}
 
 	reply := params.At(1)
-	if !internal.IsExportedOrBuiltin(reply.Type()) {
-		log.Debugf("skipping %s: reply parameter's type %s is not exported", method.Name(), reply.Type())
-		return false
+	if path := internal.UnexportedPath(reply.Type()); path != "" {
+		return reject("reply-unexported", "reply parameter's type is not exported: %s", path)
 	}
 
 	if _, ok := reply.Type().(*types.Pointer); !ok {
-		log.Debugf("skipping %s: reply type %s is not a pointer", method.Name(), reply.Type())
-		return false
+		return reject("reply-not-pointer", "reply type %s is not a pointer", reply.Type())
 	}
 
 	returns := signature.Results()
 	if returns.Len() != 1 {
-		log.Debugf("skipping %s: expected 1 return value, found %d", method.Name(), returns.Len())
-		return false
+		return reject("result-count", "expected 1 return value, found %d", returns.Len())
 	}
 
-	err := returns.At(0)
-	if err.Type().String() != "error" {
-		log.Debugf("skipping %s: expected func to return `error`, found %s", method.Name(), err.Type().String())
-	}
+	return nil
+}
 
-	return true
+func reject(rule, format string, args ...interface{}) *provider.Rejection {
+	return &provider.Rejection{
+		Rule:   rule,
+		Reason: fmt.Sprintf(format, args...),
+	}
 }
 
 // GetArgType extracts metadata about the response type from an RPC method.
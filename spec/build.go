@@ -0,0 +1,393 @@
+package spec
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/segmentio/glue/annotation"
+	"github.com/segmentio/glue/provider"
+	"golang.org/x/tools/go/loader"
+)
+
+// Input is used to build a Service.
+type Input struct {
+	// Program supplies doc comments and positions. It's optional.
+	Program *loader.Program
+	// Provider determines the arg and reply types of methods.
+	Provider provider.Provider
+	// Service is the name of the RPC service (e.g. `Math` in `Math.Sum`).
+	Service string
+	// Declaration is the RPC declaration (e.g. `type Service struct{}`).
+	Declaration *types.Named
+	// Methods are the RPC methods of Declaration.
+	Methods []*types.Func
+	// Annotations holds the directives of Methods keyed by method name.
+	Annotations map[string]annotation.Annotations
+	// Root is the directory Method.File is relative to (e.g. the module
+	// root), so that descriptions don't depend on where the module is
+	// checked out. Files are absolute if it's empty.
+	Root string
+}
+
+// Build describes the service of a type-checked declaration.
+func Build(in Input) *Service {
+	b := &builder{
+		in:   in,
+		docs: map[string]map[token.Pos]string{},
+	}
+
+	obj := in.Declaration.Obj()
+	b.svc = &Service{
+		Name:        in.Service,
+		Declaration: obj.Name(),
+		Package:     stripVendor(obj.Pkg().Path()),
+		PackageName: obj.Pkg().Name(),
+		Provider:    in.Provider.Name(),
+		Doc:         b.doc(obj),
+		Methods:     make([]Method, 0, len(in.Methods)),
+		Types:       map[string]*Named{},
+	}
+
+	for _, f := range in.Methods {
+		b.svc.Methods = append(b.svc.Methods, b.method(f))
+	}
+
+	return b.svc
+}
+
+type builder struct {
+	in  Input
+	svc *Service
+	// docs caches the doc comments of each package's objects by position,
+	// keyed by import path.
+	docs map[string]map[token.Pos]string
+}
+
+func (b *builder) method(f *types.Func) Method {
+	a := b.in.Annotations[f.Name()]
+	m := Method{
+		Name:  f.Name(),
+		RPC:   b.in.Service + "." + f.Name(),
+		Doc:   b.doc(f),
+		Arg:   b.ref(b.in.Provider.GetArgType(f)),
+		Reply: b.ref(b.in.Provider.GetReplyType(f)),
+		Annotations: Annotations{
+			Name:            a.Name,
+			Deprecated:      a.Deprecated,
+			DeprecationNote: a.DeprecationNote,
+			Timeout:         Duration(a.Timeout),
+			Idempotent:      a.Idempotent,
+		},
+	}
+
+	// The arg precedes the reply, whatever the provider's other params.
+	if params := f.Type().(*types.Signature).Params(); params.Len() >= 2 {
+		_, m.ArgPointer = types.Unalias(params.At(params.Len() - 2).Type()).(*types.Pointer)
+	}
+
+	if b.in.Program != nil {
+		pos := b.in.Program.Fset.Position(f.Pos())
+		m.File = pos.Filename
+		if b.in.Root != "" {
+			if rel, err := filepath.Rel(b.in.Root, pos.Filename); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				m.File = filepath.ToSlash(rel)
+			}
+		}
+		m.Line = pos.Line
+	}
+
+	return m
+}
+
+func (b *builder) ref(t types.Type) TypeRef {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		return TypeRef{Kind: KindBasic, Name: t.Name()}
+	case *types.Named:
+		obj := t.Obj()
+		ref := TypeRef{Kind: KindNamed, Name: obj.Name()}
+		// Predeclared named types (e.g. error) have no package.
+		if obj.Pkg() == nil {
+			return ref
+		}
+
+		ref.Package = stripVendor(obj.Pkg().Path())
+		ref.PackageName = obj.Pkg().Name()
+		ref.TypeArgs = b.typeArgs(t.TypeArgs())
+		b.define(t, ref)
+		return ref
+	case *types.TypeParam:
+		return TypeRef{Kind: KindTypeParam, Name: t.Obj().Name()}
+	case *types.Pointer:
+		elem := b.ref(t.Elem())
+		return TypeRef{Kind: KindPointer, Elem: &elem}
+	case *types.Slice:
+		elem := b.ref(t.Elem())
+		return TypeRef{Kind: KindSlice, Elem: &elem}
+	case *types.Array:
+		elem := b.ref(t.Elem())
+		return TypeRef{Kind: KindArray, Elem: &elem, Len: t.Len()}
+	case *types.Map:
+		key := b.ref(t.Key())
+		elem := b.ref(t.Elem())
+		return TypeRef{Kind: KindMap, Key: &key, Elem: &elem}
+	case *types.Chan:
+		elem := b.ref(t.Elem())
+		return TypeRef{Kind: KindChan, Elem: &elem, Dir: chanDirs[t.Dir()]}
+	case *types.Struct:
+		return TypeRef{Kind: KindStruct, Struct: b.structOf(t)}
+	case *types.Signature:
+		return TypeRef{Kind: KindFunc, Func: b.funcOf(t)}
+	case *types.Interface:
+		return TypeRef{Kind: KindInterface, Interface: b.interfaceOf(t)}
+	}
+
+	// Tuples and unions aren't the types of values.
+	return TypeRef{Kind: KindInterface}
+}
+
+var chanDirs = map[types.ChanDir]ChanDir{
+	types.SendRecv: ChanBoth,
+	types.SendOnly: ChanSend,
+	types.RecvOnly: ChanRecv,
+}
+
+// typeArgs returns references to the type arguments of an instance.
+func (b *builder) typeArgs(list *types.TypeList) []TypeRef {
+	var ret []TypeRef
+	for i := 0; i < list.Len(); i++ {
+		ret = append(ret, b.ref(list.At(i)))
+	}
+	return ret
+}
+
+// tuple returns references to the types of the variables of a tuple (e.g. the
+// params of a signature).
+func (b *builder) tuple(tuple *types.Tuple) []TypeRef {
+	ret := []TypeRef{}
+	for i := 0; i < tuple.Len(); i++ {
+		ret = append(ret, b.ref(tuple.At(i).Type()))
+	}
+	return ret
+}
+
+func (b *builder) funcOf(sig *types.Signature) *Func {
+	return &Func{
+		Params:   b.tuple(sig.Params()),
+		Results:  b.tuple(sig.Results()),
+		Variadic: sig.Variadic(),
+	}
+}
+
+func (b *builder) interfaceOf(it *types.Interface) *Interface {
+	ret := &Interface{}
+	for i := 0; i < it.NumEmbeddeds(); i++ {
+		ret.Embedded = append(ret.Embedded, b.ref(it.EmbeddedType(i)))
+	}
+	for i := 0; i < it.NumExplicitMethods(); i++ {
+		m := it.ExplicitMethod(i)
+		ret.Methods = append(ret.Methods, InterfaceMethod{
+			Name: m.Name(),
+			Func: *b.funcOf(m.Type().(*types.Signature)),
+		})
+	}
+	return ret
+}
+
+// define adds the definition of a named type to the service.
+func (b *builder) define(t *types.Named, ref TypeRef) {
+	id := ref.ID()
+	if _, ok := b.svc.Types[id]; ok {
+		return
+	}
+
+	n := &Named{
+		Name:        ref.Name,
+		Package:     ref.Package,
+		PackageName: ref.PackageName,
+		TypeArgs:    ref.TypeArgs,
+		Doc:         b.doc(t.Obj()),
+		Marshalers:  marshalers(t),
+	}
+	// Add the type before its fields so recursive types terminate.
+	b.svc.Types[id] = n
+
+	if st, ok := t.Underlying().(*types.Struct); ok {
+		n.Struct = b.structOf(st)
+		return
+	}
+
+	u := b.ref(t.Underlying())
+	n.Underlying = &u
+}
+
+// marshalerMethods maps the methods of custom encodings to Named.Marshalers.
+var marshalerMethods = []struct {
+	method    string
+	marshaler string
+}{
+	{"MarshalJSON", MarshalerJSON},
+	{"MarshalText", MarshalerText},
+	{"MarshalBinary", MarshalerBinary},
+	{"GobEncode", MarshalerGob},
+}
+
+// marshalers lists the custom encodings t or *t implement. Methods are
+// matched by name and by returning ([]byte, error).
+func marshalers(t *types.Named) []string {
+	ms := types.NewMethodSet(types.NewPointer(t))
+
+	var ret []string
+	for _, m := range marshalerMethods {
+		sel := ms.Lookup(nil, m.method)
+		if sel == nil {
+			continue
+		}
+
+		sig := sel.Type().(*types.Signature)
+		if sig.Params().Len() != 0 || sig.Results().Len() != 2 {
+			continue
+		}
+		if sig.Results().At(0).Type().String() != "[]byte" || sig.Results().At(1).Type().String() != "error" {
+			continue
+		}
+
+		ret = append(ret, m.marshaler)
+	}
+
+	return ret
+}
+
+func (b *builder) structOf(st *types.Struct) *Struct {
+	s := &Struct{Fields: []Field{}}
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		tag := st.Tag(i)
+		s.Fields = append(s.Fields, Field{
+			Name:     f.Name(),
+			Type:     b.ref(f.Type()),
+			Embedded: f.Embedded(),
+			Tag:      tag,
+			Tags:     ParseTag(tag),
+			Doc:      b.doc(f),
+		})
+	}
+
+	return s
+}
+
+// doc returns the doc comment of obj, if its package's syntax is available.
+func (b *builder) doc(obj types.Object) string {
+	if b.in.Program == nil || obj.Pkg() == nil {
+		return ""
+	}
+
+	path := obj.Pkg().Path()
+	docs, ok := b.docs[path]
+	if !ok {
+		docs = map[token.Pos]string{}
+		if info := b.in.Program.Package(path); info != nil {
+			collectDocs(docs, info.Files)
+		}
+		b.docs[path] = docs
+	}
+
+	return docs[obj.Pos()]
+}
+
+// collectDocs indexes the doc comments of type declarations, methods and
+// struct fields by the position of their name, which is also the position of
+// their types.Object.
+func collectDocs(docs map[token.Pos]string, files []*ast.File) {
+	add := func(id *ast.Ident, groups ...*ast.CommentGroup) {
+		if id == nil {
+			return
+		}
+		for _, g := range groups {
+			// Text drops directives such as `//glue:skip`.
+			if text := strings.TrimSpace(g.Text()); text != "" {
+				docs[id.Pos()] = text
+				return
+			}
+		}
+	}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.GenDecl:
+				// The doc of a lone type spec is on its declaration.
+				if n.Tok == token.TYPE && len(n.Specs) == 1 {
+					ts := n.Specs[0].(*ast.TypeSpec)
+					add(ts.Name, ts.Doc, n.Doc, ts.Comment)
+					ast.Inspect(ts.Type, func(node ast.Node) bool {
+						if f, ok := node.(*ast.Field); ok {
+							addField(add, f)
+						}
+						return true
+					})
+					return false
+				}
+			case *ast.TypeSpec:
+				add(n.Name, n.Doc, n.Comment)
+			case *ast.Field:
+				addField(add, n)
+			case *ast.FuncDecl:
+				add(n.Name, n.Doc)
+				return false
+			}
+			return true
+		})
+	}
+}
+
+func addField(add func(*ast.Ident, ...*ast.CommentGroup), f *ast.Field) {
+	if len(f.Names) == 0 {
+		add(embeddedIdent(f.Type), f.Doc, f.Comment)
+		return
+	}
+	for _, name := range f.Names {
+		add(name, f.Doc, f.Comment)
+	}
+}
+
+// embeddedIdent returns the identifier naming an embedded field (e.g. `T` in
+// `*pkg.T`).
+func embeddedIdent(e ast.Expr) *ast.Ident {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.StarExpr:
+		return embeddedIdent(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr:
+		return embeddedIdent(e.X)
+	}
+	return nil
+}
+
+// stripVendor strips vendor directories from import paths.
+//
+// github.com/x/y/vendor/github.com/a/b -> github.com/a/b
+func stripVendor(path string) string {
+	dirs := strings.Split(path, string(filepath.Separator))
+	num := len(dirs)
+	vendorIndex := -1
+	for i := 1; i <= num; i++ {
+		dir := dirs[num-i]
+		if dir == "vendor" {
+			vendorIndex = num - i
+			break
+		}
+	}
+
+	if vendorIndex == -1 {
+		return path
+	}
+
+	return filepath.Join(dirs[vendorIndex+1:]...)
+}
@@ -0,0 +1,230 @@
+package spec_test
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/segmentio/glue/provider/stl"
+	"github.com/segmentio/glue/spec"
+)
+
+const src = `package svc
+
+type Page[T any] struct {
+	Items []T
+	Next  *Page[T]
+}
+
+type Item struct{ Name string }
+
+type Kinds[T any] struct {
+	Updates chan<- T
+	Events  <-chan string
+	Both    chan int
+	Handler func(T, ...string) (int, error)
+	Value   T
+	Anon    struct {
+		A string
+		B int ` + "`json:\"b\"`" + `
+	}
+	Any interface{ String() string }
+}
+
+type base struct {
+	ID     string
+	secret string
+}
+
+type level int
+
+type Embeds struct {
+	base
+	level
+	Named  base ` + "`json:\"named\"`" + `
+	hidden int
+	Anon   struct {
+		Visible string
+		hidden  bool
+	}
+}
+
+type Service struct{}
+
+func (s *Service) Ints(arg Page[int], reply *Page[string]) error    { return nil }
+func (s *Service) Items(arg Page[Item], reply *Page[*Item]) error   { return nil }
+func (s *Service) Kinds(arg Kinds[int], reply *struct{}) error      { return nil }
+func (s *Service) Embeds(arg Embeds, reply *struct{}) error         { return nil }
+
+type Generic[T any] struct{}
+
+func (g *Generic[T]) Get(arg T, reply *Page[T]) error { return nil }
+`
+
+func build(t *testing.T, name string) *spec.Service {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "svc.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := types.Config{Importer: importer.Default()}
+	pkg, err := conf.Check("example.com/svc", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decl := pkg.Scope().Lookup(name).Type().(*types.Named)
+	var methods []*types.Func
+	for i := 0; i < decl.NumMethods(); i++ {
+		methods = append(methods, decl.Method(i))
+	}
+
+	return spec.Build(spec.Input{
+		Provider:    &stl.Provider{},
+		Service:     name,
+		Declaration: decl,
+		Methods:     methods,
+	})
+}
+
+func goString(t *testing.T, ref spec.TypeRef) string {
+	t.Helper()
+
+	s, err := ref.GoString(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestBuildTypeArgs(t *testing.T) {
+	svc := build(t, "Service")
+
+	tests := []struct {
+		method string
+		arg    string
+		reply  string
+	}{
+		{"Ints", "example.com/svc.Page[int]", "example.com/svc.Page[string]"},
+		{"Items", "example.com/svc.Page[example.com/svc.Item]", "example.com/svc.Page[*example.com/svc.Item]"},
+	}
+	for _, test := range tests {
+		m := svc.Method(test.method)
+		if m == nil {
+			t.Fatalf("missing method %s", test.method)
+		}
+		if id := m.Arg.ID(); id != test.arg {
+			t.Errorf("%s: arg ID = %q, want %q", test.method, id, test.arg)
+		}
+		if id := m.Reply.ID(); id != test.reply {
+			t.Errorf("%s: reply ID = %q, want %q", test.method, id, test.reply)
+		}
+	}
+
+	// Each instance is defined separately, with its type args substituted.
+	for id, want := range map[string]string{
+		"example.com/svc.Page[int]":    "[]int",
+		"example.com/svc.Page[string]": "[]string",
+	} {
+		n, ok := svc.Types[id]
+		if !ok {
+			t.Errorf("missing type %s", id)
+			continue
+		}
+		if got := goString(t, n.Struct.Fields[0].Type); got != want {
+			t.Errorf("%s.Items = %s, want %s", id, got, want)
+		}
+	}
+
+	if ident := svc.Method("Items").Reply.Ident(); ident != "PageItemPtr" {
+		t.Errorf("Page[*Item] ident = %q, want PageItemPtr", ident)
+	}
+}
+
+func TestBuildKinds(t *testing.T) {
+	svc := build(t, "Service")
+
+	n := svc.Lookup(svc.Method("Kinds").Arg)
+	if n == nil {
+		t.Fatal("missing Kinds[int]")
+	}
+
+	want := map[string]string{
+		"Updates": "chan<- int",
+		"Events":  "<-chan string",
+		"Both":    "chan int",
+		"Handler": "func(int, ...string) (int, error)",
+		"Value":   "int",
+		"Anon":    `struct{A string; B int "json:\"b\""}`,
+		"Any":     "interface{String() string}",
+	}
+	for _, f := range n.Struct.Fields {
+		if got := goString(t, f.Type); got != want[f.Name] {
+			t.Errorf("%s = %s, want %s", f.Name, got, want[f.Name])
+		}
+		delete(want, f.Name)
+	}
+	for name := range want {
+		t.Errorf("missing field %s", name)
+	}
+}
+
+func TestBuildUnexportedFields(t *testing.T) {
+	svc := build(t, "Service")
+
+	n := svc.Lookup(svc.Method("Embeds").Arg)
+	if n == nil {
+		t.Fatal("missing Embeds")
+	}
+
+	got, err := spec.TypeRef{Kind: spec.KindStruct, Struct: n.Struct}.GoString(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `struct{base; level; Named base "json:\"named\""; hidden int; Anon struct{Visible string; hidden bool}}`
+	if got != want {
+		t.Errorf("Embeds = %s, want %s", got, want)
+	}
+
+	// encoding/json promotes the exported fields of embedded unexported
+	// structs, and skips other unexported fields.
+	var names []string
+	for _, p := range svc.JSONProperties(n.Struct) {
+		names = append(names, p.Name)
+	}
+	if got, want := strings.Join(names, " "), "ID named Anon"; got != want {
+		t.Errorf("properties = %s, want %s", got, want)
+	}
+}
+
+func TestBuildTypeParams(t *testing.T) {
+	svc := build(t, "Generic")
+
+	m := svc.Method("Get")
+	if m == nil {
+		t.Fatal("missing method Get")
+	}
+	if m.Arg.Kind != spec.KindTypeParam || m.Arg.Name != "T" {
+		t.Errorf("arg = %+v, want type param T", m.Arg)
+	}
+	if id := m.Reply.ID(); id != "example.com/svc.Page[T]" {
+		t.Errorf("reply ID = %q, want example.com/svc.Page[T]", id)
+	}
+}
+
+func TestGoStringUnknownKind(t *testing.T) {
+	ref := spec.TypeRef{Kind: spec.KindSlice, Elem: &spec.TypeRef{Kind: "bogus"}}
+	if s, err := ref.GoString(nil); err == nil {
+		t.Errorf("GoString = %q, want error", s)
+	}
+	if s := ref.String(); s != `<spec: unknown kind "bogus">` {
+		t.Errorf("String = %q", s)
+	}
+}
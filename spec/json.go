@@ -0,0 +1,193 @@
+package spec
+
+import (
+	"go/token"
+	"strings"
+)
+
+// A JSONProperty is a property of the object encoding/json encodes a struct as.
+type JSONProperty struct {
+	// Name is the key of the property.
+	Name string
+	// Field is the struct field, possibly promoted from an embedded struct.
+	Field Field
+	// OmitEmpty is set by the `omitempty` or `omitzero` tag options.
+	OmitEmpty bool
+	// String is set by the `string` tag option on a field of a string,
+	// numeric or boolean type (or a pointer to one): its value is encoded
+	// within a JSON string.
+	String bool
+	// Optional properties may be missing: they're OmitEmpty or promoted
+	// through an embedded pointer.
+	Optional bool
+}
+
+// JSONProperties returns the properties of the object encoding/json encodes
+// st as, in encoding order. Untagged embedded structs are flattened and name
+// conflicts are resolved the way encoding/json does.
+func (s *Service) JSONProperties(st *Struct) []JSONProperty {
+	var fields []jsonField
+	s.collectJSONFields(st, 0, false, map[string]bool{}, &fields)
+
+	// Keep the shallowest field of each name, or the tagged one if several
+	// are the shallowest. Conflicting fields are dropped.
+	byName := map[string][]jsonField{}
+	var names []string
+	for _, f := range fields {
+		if byName[f.Name] == nil {
+			names = append(names, f.Name)
+		}
+		byName[f.Name] = append(byName[f.Name], f)
+	}
+
+	var ret []JSONProperty
+	for _, name := range names {
+		if f, ok := dominantField(byName[name]); ok {
+			ret = append(ret, f.JSONProperty)
+		}
+	}
+
+	return ret
+}
+
+type jsonField struct {
+	JSONProperty
+	depth  int
+	tagged bool
+}
+
+func (s *Service) collectJSONFields(st *Struct, depth int, optional bool, visiting map[string]bool, out *[]jsonField) {
+	for _, f := range st.Fields {
+		tag := f.Tags["json"]
+		if tag == "-" {
+			continue
+		}
+		name, opts := parseJSONTag(tag)
+
+		if f.Embedded {
+			t := f.Type
+			ptr := t.Kind == KindPointer
+			if ptr {
+				t = *t.Elem
+			}
+
+			var embedded *Struct
+			if n := s.Lookup(t); n != nil && n.Struct != nil {
+				embedded = n.Struct
+			} else if t.Kind == KindStruct {
+				embedded = t.Struct
+			}
+
+			// encoding/json ignores embedded unexported types, unless they're
+			// structs: their exported fields are still promoted.
+			if embedded == nil && !token.IsExported(f.Name) {
+				continue
+			}
+
+			if embedded != nil && name == "" && !visiting[t.ID()] {
+				visiting[t.ID()] = true
+				s.collectJSONFields(embedded, depth+1, optional || ptr, visiting, out)
+				delete(visiting, t.ID())
+				continue
+			}
+		} else if !token.IsExported(f.Name) {
+			continue
+		}
+
+		p := JSONProperty{
+			Name:      name,
+			Field:     f,
+			OmitEmpty: hasOption(opts, "omitempty") || hasOption(opts, "omitzero"),
+			String:    hasOption(opts, "string") && s.quotable(f.Type),
+		}
+		if p.Name == "" {
+			p.Name = f.Name
+		}
+		p.Optional = optional || p.OmitEmpty
+
+		*out = append(*out, jsonField{JSONProperty: p, depth: depth, tagged: name != ""})
+	}
+}
+
+func dominantField(fields []jsonField) (jsonField, bool) {
+	shallowest := fields[0].depth
+	for _, f := range fields[1:] {
+		if f.depth < shallowest {
+			shallowest = f.depth
+		}
+	}
+
+	var candidates []jsonField
+	for _, f := range fields {
+		if f.depth == shallowest {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	var tagged []jsonField
+	for _, f := range candidates {
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+
+	return jsonField{}, false
+}
+
+// quotable reports whether encoding/json honors the `string` option for t.
+func (s *Service) quotable(t TypeRef) bool {
+	if t.Kind == KindPointer {
+		t = *t.Elem
+	}
+
+	b := s.Underlying(t)
+	if b.Kind != KindBasic {
+		return false
+	}
+
+	switch b.Name {
+	case "complex64", "complex128", "uintptr", "unsafe.Pointer":
+		return false
+	}
+	return true
+}
+
+// Underlying resolves named types with a non-struct definition (e.g.
+// `type Status string`) to their definition. Other types are returned as is.
+func (s *Service) Underlying(t TypeRef) TypeRef {
+	for {
+		n := s.Lookup(t)
+		if n == nil || n.Underlying == nil {
+			return t
+		}
+		t = *n.Underlying
+	}
+}
+
+// parseJSONTag splits a `json` struct tag into its name and options.
+func parseJSONTag(tag string) (string, string) {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+	return tag, ""
+}
+
+func hasOption(opts, option string) bool {
+	for opts != "" {
+		var next string
+		if i := strings.Index(opts, ","); i >= 0 {
+			opts, next = opts[:i], opts[i+1:]
+		}
+		if opts == option {
+			return true
+		}
+		opts = next
+	}
+	return false
+}
@@ -0,0 +1,587 @@
+// Package spec describes RPC services independently of go/types and of any
+// output format. The Walker builds a Service once per declaration and every
+// generator renders from it, so new outputs only need to understand this model.
+//
+// All types encode to JSON, e.g. for tools written in other languages.
+package spec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Service is an RPC service and the types its methods reference.
+type Service struct {
+	// Name is the name of the RPC service (e.g. `Math` in `Math.Sum`).
+	Name string `json:"name"`
+	// Declaration is the name of the type declaring the methods (e.g. `Service`).
+	Declaration string `json:"declaration"`
+	// Package is the import path of the package declaring the service.
+	Package string `json:"package"`
+	// PackageName is the name of that package (e.g. `math`).
+	PackageName string `json:"packageName"`
+	// Provider is the name of the provider that selected the methods (e.g. `stl`).
+	Provider string `json:"provider"`
+	// Doc is the doc comment of the declaration.
+	Doc string `json:"doc,omitempty"`
+	// Methods are the RPC methods, in declaration order.
+	Methods []Method `json:"methods"`
+	// Types are the named types referenced by the methods, directly or through
+	// fields, keyed by their ID (see TypeRef.ID).
+	Types map[string]*Named `json:"types"`
+}
+
+// Method returns the method with the supplied name, or nil if there's none.
+func (s *Service) Method(name string) *Method {
+	for i := range s.Methods {
+		if s.Methods[i].Name == name {
+			return &s.Methods[i]
+		}
+	}
+	return nil
+}
+
+// Lookup returns the named type t refers to, or nil if t isn't named or its
+// definition isn't part of the service (e.g. `error`).
+func (s *Service) Lookup(t TypeRef) *Named {
+	if t.Kind != KindNamed {
+		return nil
+	}
+	return s.Types[t.ID()]
+}
+
+// A Method is an RPC method.
+type Method struct {
+	// Name is the name of the method on the server (e.g. `Sum`).
+	Name string `json:"name"`
+	// RPC is the name clients call (e.g. `Math.Sum`).
+	RPC string `json:"rpc"`
+	// Doc is the doc comment of the method, without glue directives.
+	Doc string `json:"doc,omitempty"`
+	// Arg and Reply are the types sent and received, with the pointers of the
+	// method's parameters removed.
+	Arg   TypeRef `json:"arg"`
+	Reply TypeRef `json:"reply"`
+	// ArgPointer is set if the method takes a pointer to Arg.
+	ArgPointer bool `json:"argPointer,omitempty"`
+	// Annotations are the glue directives of the method.
+	Annotations Annotations `json:"annotations"`
+	// File and Line locate the method's declaration. File is slash-separated
+	// and relative to the module root when glue describes the service.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// ClientName is the name of the method in generated clients: the annotated
+// name, if any, or Name.
+func (m Method) ClientName() string {
+	if m.Annotations.Name != "" {
+		return m.Annotations.Name
+	}
+	return m.Name
+}
+
+// Annotations are the `//glue:` directives of a method.
+type Annotations struct {
+	// Name renames the method in generated clients.
+	Name string `json:"name,omitempty"`
+	// Deprecated is set by `//glue:deprecated`, with an optional note.
+	Deprecated      bool   `json:"deprecated,omitempty"`
+	DeprecationNote string `json:"deprecationNote,omitempty"`
+	// Timeout bounds calls of the method. Zero means no timeout.
+	Timeout Duration `json:"timeout,omitempty"`
+	// Idempotent methods are safe to retry.
+	Idempotent bool `json:"idempotent,omitempty"`
+}
+
+// Duration is a time.Duration that encodes to JSON as a string (e.g. `2s`).
+type Duration time.Duration
+
+// MarshalText satisfies encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText satisfies encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Kind classifies a TypeRef.
+type Kind string
+
+const (
+	// KindBasic is a predeclared type (e.g. `string`, `int64`).
+	KindBasic Kind = "basic"
+	// KindNamed is a reference to a named type (e.g. `math.SumArg`, `error`),
+	// possibly an instance of a generic type (e.g. `math.Page[int]`).
+	KindNamed Kind = "named"
+	// KindPointer, KindSlice, KindArray, KindMap and KindChan are composite
+	// types of Elem.
+	KindPointer Kind = "pointer"
+	KindSlice   Kind = "slice"
+	KindArray   Kind = "array"
+	KindMap     Kind = "map"
+	KindChan    Kind = "chan"
+	// KindStruct is an anonymous struct.
+	KindStruct Kind = "struct"
+	// KindInterface is an anonymous interface (e.g. `interface{}`).
+	KindInterface Kind = "interface"
+	// KindFunc is a function type.
+	KindFunc Kind = "func"
+	// KindTypeParam is a type parameter of a generic type (e.g. `T`).
+	KindTypeParam Kind = "typeParam"
+)
+
+// ChanDir is the direction of a channel type.
+type ChanDir string
+
+const (
+	// ChanBoth channels send and receive (e.g. `chan int`).
+	ChanBoth ChanDir = ""
+	// ChanSend channels only send (e.g. `chan<- int`).
+	ChanSend ChanDir = "send"
+	// ChanRecv channels only receive (e.g. `<-chan int`).
+	ChanRecv ChanDir = "recv"
+)
+
+// A TypeRef is a reference to a type.
+type TypeRef struct {
+	Kind Kind `json:"kind"`
+	// Name is the name of a basic or named type, or of a type parameter.
+	Name string `json:"name,omitempty"`
+	// Package is the import path of a named type. It's empty for predeclared
+	// named types (e.g. `error`).
+	Package string `json:"package,omitempty"`
+	// PackageName is the name of Package (e.g. `math`).
+	PackageName string `json:"packageName,omitempty"`
+	// TypeArgs are the type arguments of an instance of a generic type (e.g.
+	// `int` in `Page[int]`).
+	TypeArgs []TypeRef `json:"typeArgs,omitempty"`
+	// Elem is the element type of pointers, slices, arrays, maps and channels.
+	Elem *TypeRef `json:"elem,omitempty"`
+	// Key is the key type of maps.
+	Key *TypeRef `json:"key,omitempty"`
+	// Len is the length of arrays.
+	Len int64 `json:"len,omitempty"`
+	// Dir is the direction of channels.
+	Dir ChanDir `json:"dir,omitempty"`
+	// Struct describes an anonymous struct.
+	Struct *Struct `json:"struct,omitempty"`
+	// Interface describes an anonymous interface.
+	Interface *Interface `json:"interface,omitempty"`
+	// Func describes the signature of a function type.
+	Func *Func `json:"func,omitempty"`
+}
+
+// ID identifies a named type within a Service (e.g. `example.com/math.SumArg`
+// or `example.com/math.Page[int]`). It's empty for other kinds.
+func (t TypeRef) ID() string {
+	if t.Kind != KindNamed {
+		return ""
+	}
+
+	// Qualify by import path, as go/types does, so that IDs are unique.
+	id, err := t.GoString(func(t TypeRef) string {
+		return t.Package
+	})
+	if err != nil {
+		return t.Package + "." + t.Name
+	}
+	return id
+}
+
+// Ident returns an identifier for a named type that tells the instances of a
+// generic type apart: its name followed by the identifiers of its type args
+// (e.g. `PageString` for `Page[string]`). Other types are identified by their
+// elements and kind (e.g. `StringSlice` for `[]string`, `ItemPtr` for `*Item`).
+func (t TypeRef) Ident() string {
+	ident := t.Name
+	switch t.Kind {
+	case KindBasic, KindTypeParam:
+		ident = exported(t.Name)
+	case KindNamed:
+		for _, arg := range t.TypeArgs {
+			ident += arg.Ident()
+		}
+	case KindPointer:
+		ident = t.Elem.Ident() + "Ptr"
+	case KindSlice, KindArray, KindChan:
+		ident = t.Elem.Ident() + exported(string(t.Kind))
+	case KindMap:
+		ident = t.Key.Ident() + t.Elem.Ident() + "Map"
+	default:
+		ident = exported(string(t.Kind))
+	}
+	return ident
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// String formats t as Go source, qualifying named types by package name
+// (e.g. `map[string]*math.SumArg`).
+func (t TypeRef) String() string {
+	s, err := t.GoString(func(t TypeRef) string {
+		return t.PackageName
+	})
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return s
+}
+
+// GoString formats t as Go source, qualifying named types by the result of
+// qualifier, if any, unless it's empty. It fails if t, or a type within it, has an
+// unknown Kind or lacks the fields its Kind requires.
+func (t TypeRef) GoString(qualifier func(TypeRef) string) (string, error) {
+	var b strings.Builder
+	if err := t.writeGo(&b, qualifier); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (t TypeRef) writeGo(b *strings.Builder, qualifier func(TypeRef) string) error {
+	switch t.Kind {
+	case KindBasic, KindTypeParam:
+		b.WriteString(t.Name)
+		return nil
+	case KindNamed:
+		if t.Package != "" && qualifier != nil {
+			if q := qualifier(t); q != "" {
+				b.WriteString(q + ".")
+			}
+		}
+		b.WriteString(t.Name)
+		if len(t.TypeArgs) == 0 {
+			return nil
+		}
+
+		b.WriteString("[")
+		if err := writeList(b, t.TypeArgs, false, qualifier); err != nil {
+			return err
+		}
+		b.WriteString("]")
+		return nil
+	case KindInterface:
+		return t.Interface.writeGo(b, qualifier)
+	case KindStruct:
+		return t.Struct.writeGo(b, qualifier)
+	case KindFunc:
+		if t.Func == nil {
+			return fmt.Errorf("spec: func type without signature")
+		}
+		b.WriteString("func")
+		return t.Func.writeGo(b, qualifier)
+	case KindPointer, KindSlice, KindArray, KindMap, KindChan:
+	default:
+		return fmt.Errorf("spec: unknown kind %q", t.Kind)
+	}
+
+	if t.Elem == nil {
+		return fmt.Errorf("spec: %s type without element type", t.Kind)
+	}
+
+	switch t.Kind {
+	case KindPointer:
+		b.WriteString("*")
+	case KindSlice:
+		b.WriteString("[]")
+	case KindArray:
+		b.WriteString("[" + strconv.FormatInt(t.Len, 10) + "]")
+	case KindMap:
+		if t.Key == nil {
+			return fmt.Errorf("spec: map type without key type")
+		}
+		b.WriteString("map[")
+		if err := t.Key.writeGo(b, qualifier); err != nil {
+			return err
+		}
+		b.WriteString("]")
+	case KindChan:
+		switch t.Dir {
+		case ChanBoth:
+			b.WriteString("chan ")
+			// `chan <-chan T` would parse as `chan<- chan T`.
+			if t.Elem.Kind == KindChan && t.Elem.Dir == ChanRecv {
+				b.WriteString("(")
+				if err := t.Elem.writeGo(b, qualifier); err != nil {
+					return err
+				}
+				b.WriteString(")")
+				return nil
+			}
+		case ChanSend:
+			b.WriteString("chan<- ")
+		case ChanRecv:
+			b.WriteString("<-chan ")
+		default:
+			return fmt.Errorf("spec: unknown channel direction %q", t.Dir)
+		}
+	}
+
+	return t.Elem.writeGo(b, qualifier)
+}
+
+// writeList writes types separated by commas. If variadic, the last one is a
+// slice written as `...Elem`.
+func writeList(b *strings.Builder, types []TypeRef, variadic bool, qualifier func(TypeRef) string) error {
+	for i, t := range types {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if variadic && i == len(types)-1 && t.Kind == KindSlice && t.Elem != nil {
+			b.WriteString("...")
+			t = *t.Elem
+		}
+		if err := t.writeGo(b, qualifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A Func is the signature of a function type or of an interface method.
+type Func struct {
+	Params  []TypeRef `json:"params"`
+	Results []TypeRef `json:"results"`
+	// Variadic is set if the last of Params, a slice, is a `...` parameter.
+	Variadic bool `json:"variadic,omitempty"`
+}
+
+func (f *Func) writeGo(b *strings.Builder, qualifier func(TypeRef) string) error {
+	b.WriteString("(")
+	if err := writeList(b, f.Params, f.Variadic, qualifier); err != nil {
+		return err
+	}
+	b.WriteString(")")
+
+	switch len(f.Results) {
+	case 0:
+		return nil
+	case 1:
+		b.WriteString(" ")
+		return f.Results[0].writeGo(b, qualifier)
+	}
+
+	b.WriteString(" (")
+	if err := writeList(b, f.Results, false, qualifier); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+// An Interface is an interface type.
+type Interface struct {
+	// Embedded are the embedded interfaces (e.g. `io.Reader`).
+	Embedded []TypeRef `json:"embedded,omitempty"`
+	// Methods are the methods declared by the interface itself.
+	Methods []InterfaceMethod `json:"methods,omitempty"`
+}
+
+// An InterfaceMethod is a method of an Interface.
+type InterfaceMethod struct {
+	Name string `json:"name"`
+	Func Func   `json:"func"`
+}
+
+func (i *Interface) writeGo(b *strings.Builder, qualifier func(TypeRef) string) error {
+	if i == nil || len(i.Embedded)+len(i.Methods) == 0 {
+		b.WriteString("interface{}")
+		return nil
+	}
+
+	b.WriteString("interface{")
+	for j, t := range i.Embedded {
+		if j > 0 {
+			b.WriteString("; ")
+		}
+		if err := t.writeGo(b, qualifier); err != nil {
+			return err
+		}
+	}
+	for j, m := range i.Methods {
+		if j > 0 || len(i.Embedded) > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(m.Name)
+		if err := m.Func.writeGo(b, qualifier); err != nil {
+			return err
+		}
+	}
+	b.WriteString("}")
+	return nil
+}
+
+// A Named is the definition of a named type.
+type Named struct {
+	// Name is the name of the type (e.g. `SumArg`).
+	Name string `json:"name"`
+	// Package is the import path of the package declaring the type.
+	Package string `json:"package"`
+	// PackageName is the name of that package.
+	PackageName string `json:"packageName"`
+	// TypeArgs are the type arguments of instances of generic types.
+	TypeArgs []TypeRef `json:"typeArgs,omitempty"`
+	// Doc is the doc comment of the type.
+	Doc string `json:"doc,omitempty"`
+	// Struct describes the fields of struct types.
+	Struct *Struct `json:"struct,omitempty"`
+	// Underlying is the definition of other types (e.g. `string` in
+	// `type Status string`).
+	Underlying *TypeRef `json:"underlying,omitempty"`
+	// Marshalers lists the custom encodings the type implements (e.g.
+	// MarshalerJSON for `time.Time`). They take precedence over its definition.
+	Marshalers []string `json:"marshalers,omitempty"`
+}
+
+// Custom encodings listed in Named.Marshalers.
+const (
+	// MarshalerJSON is encoding/json.Marshaler.
+	MarshalerJSON = "json"
+	// MarshalerText is encoding.TextMarshaler.
+	MarshalerText = "text"
+	// MarshalerBinary is encoding.BinaryMarshaler.
+	MarshalerBinary = "binary"
+	// MarshalerGob is encoding/gob.GobEncoder.
+	MarshalerGob = "gob"
+)
+
+// Implements reports whether n implements the custom encoding marshaler
+// (e.g. MarshalerJSON).
+func (n *Named) Implements(marshaler string) bool {
+	for _, m := range n.Marshalers {
+		if m == marshaler {
+			return true
+		}
+	}
+	return false
+}
+
+// Ref returns a reference to n.
+func (n *Named) Ref() TypeRef {
+	return TypeRef{
+		Kind:        KindNamed,
+		Name:        n.Name,
+		Package:     n.Package,
+		PackageName: n.PackageName,
+		TypeArgs:    n.TypeArgs,
+	}
+}
+
+// Ident returns an identifier for n (see TypeRef.Ident).
+func (n *Named) Ident() string {
+	return n.Ref().Ident()
+}
+
+// A Struct is a struct type. Every field is described, unexported ones
+// included; JSONProperties tells which ones encoding/json encodes.
+type Struct struct {
+	Fields []Field `json:"fields"`
+}
+
+func (s *Struct) writeGo(b *strings.Builder, qualifier func(TypeRef) string) error {
+	if s == nil {
+		return fmt.Errorf("spec: struct type without fields")
+	}
+
+	b.WriteString("struct{")
+	for i, f := range s.Fields {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		if !f.Embedded {
+			b.WriteString(f.Name + " ")
+		}
+		if err := f.Type.writeGo(b, qualifier); err != nil {
+			return err
+		}
+		if f.Tag != "" {
+			b.WriteString(" " + strconv.Quote(f.Tag))
+		}
+	}
+	b.WriteString("}")
+	return nil
+}
+
+// A Field is a struct field.
+type Field struct {
+	// Name is the name of the field. For embedded fields, it's the name of
+	// the type.
+	Name string  `json:"name"`
+	Type TypeRef `json:"type"`
+	// Embedded is set for embedded fields.
+	Embedded bool `json:"embedded,omitempty"`
+	// Tag is the raw struct tag (e.g. `json:"sum,omitempty"`).
+	Tag string `json:"tag,omitempty"`
+	// Tags are the key/value pairs of Tag (e.g. `json` to `sum,omitempty`).
+	Tags map[string]string `json:"tags,omitempty"`
+	// Doc is the doc comment, or the line comment, of the field.
+	Doc string `json:"doc,omitempty"`
+}
+
+// ParseTag parses a struct tag into its key/value pairs, following the
+// conventions of reflect.StructTag. Parsing stops at the first malformed pair.
+func ParseTag(tag string) map[string]string {
+	var ret map[string]string
+	for tag != "" {
+		// Skip leading space.
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			break
+		}
+
+		// Scan to colon. A space, a quote or a control character is a syntax error.
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// Scan quoted string to find value.
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			break
+		}
+		tag = tag[i+1:]
+
+		if ret == nil {
+			ret = map[string]string{}
+		}
+		ret[key] = value
+	}
+
+	return ret
+}
@@ -0,0 +1,27 @@
+package glue
+
+import "runtime/debug"
+
+// Version is the version of glue recorded in generated code. Release builds
+// may set it with `-ldflags "-X github.com/segmentio/glue.Version=v1.2.3"`;
+// otherwise it falls back to the module version glue was built from.
+var Version = moduleVersion()
+
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+
+	if info.Main.Path == "github.com/segmentio/glue" {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == "github.com/segmentio/glue" {
+			return dep.Version
+		}
+	}
+
+	return "(devel)"
+}
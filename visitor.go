@@ -1,9 +1,16 @@
 package glue
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
+	"log/slog"
+	"strings"
 
+	"github.com/segmentio/glue/annotation"
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/log"
 	"github.com/segmentio/glue/provider"
 
 	"golang.org/x/tools/go/loader"
@@ -12,9 +19,16 @@ import (
 // Visitor traverses a Go package's AST, visits declarations that satisfy the
 // structure of an RPC service, and extracts their RPC methods.
 type Visitor struct {
-	pkg      *loader.PackageInfo
-	methods  map[string][]*types.Func
-	provider provider.Provider
+	pkg         *loader.PackageInfo
+	methods     map[string][]*types.Func
+	annotations map[string]annotation.Annotations
+	provider    provider.Provider
+	logger      *slog.Logger
+	reporter    diagnostic.Reporter
+	fset        *token.FileSet
+	decl        *types.Named
+	invalid     []string
+	errs        map[string]error
 
 	target string
 }
@@ -27,28 +41,88 @@ type VisitorConfig struct {
 	Provider provider.Provider
 	// Declaration is the name of the target RPC declaration (method receiver).
 	Declaration string
+	// Logger defaults to log.Default.
+	Logger *slog.Logger
+	// Reporter receives diagnostics about invalid annotations and exported
+	// methods the Provider finds unsuitable. It's optional.
+	Reporter diagnostic.Reporter
+	// Fset locates diagnostics. Without it, diagnostics have no position.
+	Fset *token.FileSet
 }
 
 // NewVisitor creates a Visitor.
 func NewVisitor(cfg VisitorConfig) *Visitor {
 	return &Visitor{
-		pkg:      cfg.Pkg,
-		provider: cfg.Provider,
-		methods:  map[string][]*types.Func{},
-		target:   cfg.Declaration,
+		pkg:         cfg.Pkg,
+		provider:    cfg.Provider,
+		methods:     map[string][]*types.Func{},
+		annotations: map[string]annotation.Annotations{},
+		errs:        map[string]error{},
+		target:      cfg.Declaration,
+		reporter:    diagnostic.OrDiscard(cfg.Reporter),
+		fset:        cfg.Fset,
+		logger: log.OrDefault(cfg.Logger).With(
+			slog.String(log.KeyPackage, cfg.Pkg.Pkg.Path()),
+			slog.String(log.KeyDeclaration, cfg.Declaration)),
 	}
 }
 
 // Go starts Visitor's trip around the supplied package. Upon return, it
-// sends a mapping of receiver identifiers to RPC methods.
+// sends a mapping of receiver identifiers to RPC methods. Methods annotated
+// with `//glue:skip` are left out.
 func (p *Visitor) Go() map[string][]*types.Func {
 	for _, file := range p.pkg.Files {
 		ast.Walk(p, file)
 	}
 
+	for recv, funcs := range p.methods {
+		kept := funcs[:0]
+		for _, f := range funcs {
+			if p.annotations[f.Name()].Skip {
+				p.logger.Debug("skipping method",
+					slog.String(log.KeyMethod, f.Name()),
+					slog.String(log.KeyReason, "annotated with glue:skip"))
+				continue
+			}
+			kept = append(kept, f)
+		}
+
+		if len(kept) == 0 {
+			delete(p.methods, recv)
+			continue
+		}
+		p.methods[recv] = kept
+	}
+
 	return p.methods
 }
 
+// Annotations returns the directives parsed from the doc comments of the
+// target declaration's methods, keyed by method name.
+func (p *Visitor) Annotations() map[string]annotation.Annotations {
+	return p.annotations
+}
+
+// Err returns an error if the doc comment of a method of the target
+// declaration has an invalid directive. Each one is reported to the Reporter.
+func (p *Visitor) Err() error {
+	if len(p.invalid) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid annotations on %s", strings.Join(p.invalid, ", "))
+}
+
+// AnnotationErr returns the error parsing the directives of the named method of
+// the target declaration, if any.
+func (p *Visitor) AnnotationErr(method string) error {
+	return p.errs[method]
+}
+
+// Declaration returns the target RPC declaration, or nil if it wasn't found.
+func (p *Visitor) Declaration() *types.Named {
+	return p.decl
+}
+
 // Visit extracts functions from RPC declarations. It satisfies go/ast.Visitor.
 func (p *Visitor) Visit(node ast.Node) ast.Visitor {
 	switch n := node.(type) {
@@ -56,6 +130,8 @@ func (p *Visitor) Visit(node ast.Node) ast.Visitor {
 		return nil
 	case *ast.TypeSpec:
 		p.visitType(n)
+	case *ast.FuncDecl:
+		p.visitFunc(n)
 	}
 
 	return p
@@ -75,6 +151,7 @@ func (p *Visitor) visitType(ts *ast.TypeSpec) {
 	if obj.Name() != p.target {
 		return
 	}
+	p.decl = namedType
 
 	for i := 0; i < namedType.NumMethods(); i++ {
 		method := namedType.Method(i)
@@ -82,6 +159,78 @@ func (p *Visitor) visitType(ts *ast.TypeSpec) {
 
 			recv := namedType.Obj().Name()
 			p.methods[recv] = append(p.methods[recv], method)
+			continue
 		}
+
+		p.reportUnsuitable(method)
+	}
+}
+
+// reportUnsuitable reports exported methods the Provider rejects; they may be
+// meant as RPC methods. Unexported methods are expected to be skipped.
+func (p *Visitor) reportUnsuitable(method *types.Func) {
+	explainer, ok := p.provider.(provider.Explainer)
+	if !ok || !method.Exported() {
+		return
+	}
+
+	rejection := explainer.Explain(method)
+	if rejection == nil {
+		return
 	}
+
+	p.reporter.Report(diagnostic.At(p.position(method.Pos()), diagnostic.SeverityNote, rejection.Rule,
+		fmt.Sprintf("%s is not an RPC method: %s", method.Name(), rejection.Reason)))
+}
+
+func (p *Visitor) position(pos token.Pos) token.Position {
+	if p.fset == nil {
+		return token.Position{}
+	}
+	return p.fset.Position(pos)
+}
+
+// visitFunc parses glue directives from the doc comments of the target
+// declaration's methods.
+func (p *Visitor) visitFunc(fd *ast.FuncDecl) {
+	if fd.Recv == nil || fd.Doc == nil {
+		return
+	}
+
+	fn, ok := p.pkg.Info.ObjectOf(fd.Name).(*types.Func)
+	if !ok {
+		return
+	}
+
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return
+	}
+
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	namedType, ok := t.(*types.Named)
+	if !ok || namedType.Obj().Name() != p.target {
+		return
+	}
+
+	a, err := annotation.Parse(fd.Doc)
+	if err != nil {
+		p.invalid = append(p.invalid, fn.Name())
+		p.errs[fn.Name()] = err
+		p.logger.Error("invalid annotation",
+			slog.String(log.KeyMethod, fn.Name()),
+			log.Err(err))
+		pos := fd.Doc.Pos()
+		if e, ok := err.(*annotation.Error); ok {
+			pos = e.Pos
+		}
+		p.reporter.Report(diagnostic.At(p.position(pos), diagnostic.SeverityError, "annotation",
+			fmt.Sprintf("invalid annotation on %s: %s", fn.Name(), err.Error())))
+	}
+
+	p.annotations[fn.Name()] = a
 }
@@ -1,14 +1,32 @@
 package glue
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"go/build"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 	"sync"
+	"text/template"
 
+	"github.com/segmentio/glue/diagnostic"
+	"github.com/segmentio/glue/filter"
 	"github.com/segmentio/glue/generator"
+	"github.com/segmentio/glue/internal/gen"
 	"github.com/segmentio/glue/log"
+	"github.com/segmentio/glue/manifest"
 	"github.com/segmentio/glue/provider"
+	"github.com/segmentio/glue/spec"
 	"github.com/segmentio/glue/writer"
+	"golang.org/x/mod/modfile"
 	"golang.org/x/tools/go/loader"
 )
 
@@ -18,6 +36,11 @@ type Walker struct {
 	// Provider answers RPC-implementation-specific (e.g. stl, gorilla, etc.) questions.
 	Provider provider.Provider
 	Writer   writer.Writer
+	// Logger is passed on to the Visitor and generator. It defaults to log.Default.
+	Logger *slog.Logger
+	// Reporter receives diagnostics about the server code, e.g. type errors,
+	// invalid annotations and unsuitable methods. It's passed on to the Visitor.
+	Reporter diagnostic.Reporter
 }
 
 // Directions tell the Walker where to walk and what to pay attention to along the way.
@@ -28,64 +51,716 @@ type Directions struct {
 	Name string
 	// Service is the name of the RPC service. (e.g. `Math` in `Math.Sum`)
 	Service string
+	// Include and Exclude filter the methods of the generated client by name.
+	// Patterns are globs (e.g. `Admin*`) or slash-delimited regular
+	// expressions (e.g. `/^Admin/`). They can't be combined with Clients,
+	// which have their own filters.
+	Include []string
+	Exclude []string
+	// Clients generates several clients from the one service, each with its own
+	// method filters. By default, a single client named after Service is generated.
+	Clients []ClientDirections
+	// Package is the name of the output package. It defaults to `client`.
+	Package string
+	// Format is the name of the output format (see Formats). It defaults to FormatGo.
+	Format string
+	// Filename is a text/template for the name of each output file, executed
+	// with FilenameData. It defaults to the Filename of the Format.
+	Filename string
+	// Command is the command line or config entry that produced these directions.
+	// It's recorded in the header of generated files so readers know how to
+	// regenerate them. It must be a single line.
+	Command string
+	// StampVersion records the version of glue in the header of generated
+	// files and in the manifest. It's off by default, as every rebuild of glue
+	// would otherwise make them stale.
+	StampVersion bool
+	// Manifest is the path, relative to the output, of a JSON manifest that
+	// records every generated file (e.g. `glue.manifest.json`). No manifest is
+	// written if it's empty. Outputs that implement writer.Remover lose the
+	// files it lists that are no longer generated.
+	Manifest string
+	// Mocks also generates a mock of each client for tests, with FormatGo.
+	Mocks bool
+	// InProcess also generates a client.Client per client that calls the
+	// methods of the declaration directly, for tests, with FormatGo.
+	InProcess bool
+}
+
+// DefaultFilename is the default Directions.Filename.
+const DefaultFilename = "generated_{{ .Client }}Client.go"
+
+// FilenameData is the data used to execute Directions.Filename.
+type FilenameData struct {
+	// Package is the name of the output package (e.g. `client`).
+	Package string
+	// Service is the name of the RPC service (e.g. `Math`).
+	Service string
+	// Client is the name of the generated client type (e.g. `MathAdmin`).
+	Client string
+	// Provider is the name of the provider (e.g. `gorilla`).
+	Provider string
+}
+
+// ClientDirections describe one of the clients generated for a service.
+type ClientDirections struct {
+	// Name is the name of the generated client type (e.g. `MathAdmin`).
+	Name string
+	// Include and Exclude filter the methods of the client by name.
+	Include []string
+	Exclude []string
+}
+
+type clientFilter struct {
+	name   string
+	filter *filter.Filter
+}
+
+type output struct {
+	pkg      string
+	format   Format
+	filename *template.Template
 }
 
 // Walk is the logical entrypoint for Glue. It walks the source code and asks
 func (w *Walker) Walk(directions Directions) error {
-	var conf loader.Config
-	conf.Import(directions.Path)
+	w = w.rooted(directions)
 
-	prgm, err := conf.Load()
+	if directions.Service == "" {
+		err := errors.New("service is required")
+		w.invalidDirections(err)
+		return err
+	}
+
+	if strings.ContainsAny(directions.Command, "\r\n") {
+		err := errors.New("command must be a single line")
+		w.invalidDirections(err)
+		return err
+	}
+
+	clients, err := directions.clientFilters()
+	if err != nil {
+		w.invalidDirections(err)
+		return err
+	}
+
+	out, err := directions.output()
+	if err != nil {
+		w.invalidDirections(err)
+		return err
+	}
+
+	prgm, err := w.load(directions.Path)
 	if err != nil {
-		log.Printf("failed to parse Go code: %s", err.Error())
 		return err
 	}
 
+	pkgs := prgm.InitialPackages()
+	files := make([][]manifest.File, len(pkgs))
+	errs := make([]error, len(pkgs))
+
 	var wg sync.WaitGroup
-	for _, pkg := range prgm.InitialPackages() {
+	for i, pkg := range pkgs {
 		wg.Add(1)
-		go func(p *loader.PackageInfo) {
+		go func(i int, p *loader.PackageInfo) {
 			defer wg.Done()
-			w.walkPackage(p, directions.Name, directions.Service)
-		}(pkg)
+			files[i], errs[i] = w.walkPackage(p, prgm, directions, clients, out)
+		}(i, pkg)
 	}
 
 	wg.Wait()
-	return nil
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	var generated []manifest.File
+	for _, f := range files {
+		generated = append(generated, f...)
+	}
+
+	return w.updateManifest(directions, generated)
 }
 
-func (w *Walker) walkPackage(pkg *loader.PackageInfo, decl, service string) error {
-	visitor := NewVisitor(VisitorConfig{
-		Pkg:         pkg,
-		Provider:    w.Provider,
-		Declaration: decl,
+func (d Directions) format() string {
+	if d.Format == "" {
+		return FormatGo
+	}
+	return d.Format
+}
+
+// version is the version of glue recorded in generated files, if any.
+func (d Directions) version() string {
+	if !d.StampVersion {
+		return ""
+	}
+	return Version
+}
+
+// owner identifies the files these directions generate: the declaration, keyed
+// on the import path of the package, which is the same wherever glue runs
+// from, the format and the filename template. Runs generating other formats,
+// or other filenames, in the same output don't remove each other's files.
+func (d Directions) owner() string {
+	filename := d.Filename
+	if format, ok := Formats[d.format()]; ok && filename == "" {
+		filename = format.Filename
+	}
+	return importPath(d.Path) + ":" + d.Name + ":" + d.format() + ":" + filename
+}
+
+func (d Directions) clientFilters() ([]clientFilter, error) {
+	clients := d.Clients
+	if len(clients) == 0 {
+		clients = []ClientDirections{{
+			Name:    d.Service,
+			Include: d.Include,
+			Exclude: d.Exclude,
+		}}
+	} else {
+		if len(d.Include) > 0 || len(d.Exclude) > 0 {
+			return nil, errors.New("include and exclude can't be combined with clients, filter each client instead")
+		}
+
+		names := make(map[string]bool, len(clients))
+		for _, c := range clients {
+			if c.Name == "" {
+				return nil, errors.New("client name is required")
+			}
+			if !token.IsIdentifier(c.Name) {
+				return nil, fmt.Errorf("invalid client name %q", c.Name)
+			}
+			if names[c.Name] {
+				return nil, fmt.Errorf("duplicate client %s", c.Name)
+			}
+			names[c.Name] = true
+		}
+	}
+
+	ret := make([]clientFilter, 0, len(clients))
+	for _, c := range clients {
+		f, err := filter.New(c.Include, c.Exclude)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, clientFilter{name: c.Name, filter: f})
+	}
+
+	return ret, nil
+}
+
+func (d Directions) output() (output, error) {
+	out := output{pkg: d.Package}
+	if out.pkg == "" {
+		out.pkg = "client"
+	}
+	if !token.IsIdentifier(out.pkg) {
+		return out, fmt.Errorf("invalid package name %q", out.pkg)
+	}
+
+	format, ok := Formats[d.format()]
+	if !ok {
+		return out, fmt.Errorf("unknown format %q", d.Format)
+	}
+	out.format = format
+
+	if d.Mocks && d.format() != FormatGo {
+		return out, fmt.Errorf("mocks require format %s, found %s", FormatGo, d.format())
+	}
+	if d.InProcess && d.format() != FormatGo {
+		return out, fmt.Errorf("in-process clients require format %s, found %s", FormatGo, d.format())
+	}
+
+	filename := d.Filename
+	if filename == "" {
+		filename = format.Filename
+	}
+
+	tmpl, err := template.New("filename").Parse(filename)
+	if err != nil {
+		return out, fmt.Errorf("invalid filename template: %s", err.Error())
+	}
+	out.filename = tmpl
+
+	return out, nil
+}
+
+func (o output) filenameFor(service, client, provider string) (string, error) {
+	var b bytes.Buffer
+	err := o.filename.Execute(&b, FilenameData{
+		Package:  o.pkg,
+		Service:  service,
+		Client:   client,
+		Provider: provider,
 	})
-	funcsByRecv := visitor.Go()
+	if err != nil {
+		return "", err
+	}
 
-	if len(funcsByRecv) == 0 {
-		log.Print("could not find RPC declaration")
-		return errors.New("not found")
+	if b.Len() == 0 {
+		return "", errors.New("filename template produced an empty filename")
 	}
 
-	for _, funcs := range funcsByRecv {
-		ident := fmt.Sprintf("%sClient", service)
-		src, err := generator.Generate(generator.GenerateInput{
-			Provider:    w.Provider,
-			PackageName: "client",
-			Service:     service,
-			Funcs:       funcs,
+	return b.String(), nil
+}
+
+func (w *Walker) load(path string) (*loader.Program, error) {
+	var conf loader.Config
+	// Comments carry method annotations (e.g. `//glue:skip`).
+	conf.ParserMode = parser.ParseComments
+	conf.TypeChecker.Error = w.sourceError
+	conf.Import(importPath(path))
+
+	prgm, err := conf.Load()
+	if err != nil {
+		w.logger().Error("failed to parse Go code", log.Err(err))
+		w.reporter().Report(diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Rule:     "load",
+			Message:  err.Error(),
+			File:     localDir(path),
 		})
+		return nil, err
+	}
+
+	return prgm, nil
+}
+
+// importPath returns the import path of the package in dir (e.g. `.` when run
+// by go generate), so that the package and the types declared in it are
+// qualified as other packages import them. Import paths are returned as is,
+// as are directories outside of modules and GOPATH.
+func importPath(dir string) string {
+	if !build.IsLocalImport(dir) && !filepath.IsAbs(dir) {
+		return dir
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	if root := moduleRoot(abs); root != "" {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
 		if err != nil {
-			return err
+			return dir
+		}
+		mod := modfile.ModulePath(data)
+		rel, err := filepath.Rel(root, abs)
+		if mod == "" || err != nil {
+			return dir
+		}
+		return path.Join(mod, filepath.ToSlash(rel))
+	}
+
+	if bp, err := build.ImportDir(abs, build.FindOnly); err == nil && !build.IsLocalImport(bp.ImportPath) {
+		return bp.ImportPath
+	}
+	return dir
+}
+
+// moduleRoot returns the directory of the go.mod of the module the absolute
+// directory dir belongs to, or "" outside modules.
+func moduleRoot(dir string) string {
+	for root := dir; ; {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err == nil {
+			return root
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return ""
+		}
+		root = parent
+	}
+}
+
+// localDir returns the absolute path of the directory path, or path if it's
+// an import path.
+func localDir(path string) string {
+	if !build.IsLocalImport(path) && !filepath.IsAbs(path) {
+		return path
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// root is the directory the files of diagnostics and of the service
+// description are relative to: the module root of Path (or of the working
+// directory, for import paths), or the directory of Path outside modules.
+func (d Directions) root() string {
+	dir := localDir(d.Path)
+	if !filepath.IsAbs(dir) {
+		dir = localDir(".")
+	}
+	if root := moduleRoot(dir); root != "" {
+		return root
+	}
+	return dir
+}
+
+// rooted returns a copy of w reporting diagnostics relative to the root of
+// directions.
+func (w *Walker) rooted(directions Directions) *Walker {
+	rooted := *w
+	rooted.Reporter = diagnostic.Relative(directions.root(), w.reporter())
+	return &rooted
+}
+
+// sourceError reports a parse or type error found while loading packages.
+func (w *Walker) sourceError(err error) {
+	var pos token.Position
+	msg := err.Error()
+	switch e := err.(type) {
+	case types.Error:
+		pos = e.Fset.Position(e.Pos)
+		msg = e.Msg
+	case scanner.Error:
+		pos = e.Pos
+		msg = e.Msg
+	}
+
+	w.logger().Error("failed to parse Go code", log.Err(err))
+	w.reporter().Report(diagnostic.At(pos, diagnostic.SeverityError, "source", msg))
+}
+
+func (w *Walker) invalidDirections(err error) {
+	w.logger().Error("invalid directions", log.Err(err))
+	w.reporter().Report(diagnostic.Diagnostic{
+		Severity: diagnostic.SeverityError,
+		Rule:     "directions",
+		Message:  err.Error(),
+	})
+}
+
+func (w *Walker) reporter() diagnostic.Reporter {
+	return diagnostic.OrDiscard(w.Reporter)
+}
+
+func (w *Walker) logger() *slog.Logger {
+	return log.OrDefault(w.Logger)
+}
+
+// updateManifest removes the files generated for the declaration of
+// directions in earlier runs but not in this one, and records files, generated
+// in this run, in the manifest next to those of other declarations. It does
+// nothing unless Directions.Manifest is set.
+func (w *Walker) updateManifest(directions Directions, files []manifest.File) error {
+	if directions.Manifest == "" {
+		return nil
+	}
+
+	remover, canRemove := w.Writer.(writer.Remover)
+	path := directions.Manifest
+	prev, err := w.readManifest(path)
+	if err != nil {
+		return err
+	}
+
+	owner := directions.owner()
+	written := gen.NewStringSet()
+	for i := range files {
+		files[i].Owner = owner
+		written.AddList(files[i].Paths())
+	}
+
+	m := manifest.Manifest{Version: directions.version(), Files: files}
+	for _, f := range prev.Files {
+		switch {
+		case f.Owner != owner:
+			// Files of other declarations are kept, unless this one took them over.
+			if !written.Contains(f.Path) {
+				m.Files = append(m.Files, f)
+			}
+		case canRemove:
+			for _, p := range f.Paths() {
+				if written.Contains(p) {
+					continue
+				}
+				if err := remover.Remove(p); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return w.writeManifest(path, &m)
+}
+
+// readManifest reads the manifest at path back from the output. It's empty if
+// there's none, or if the Writer can't read it back.
+func (w *Walker) readManifest(path string) (*manifest.Manifest, error) {
+	r, ok := w.Writer.(writer.Reader)
+	if !ok {
+		return &manifest.Manifest{}, nil
+	}
+
+	data, err := r.Read(path)
+	if os.IsNotExist(err) {
+		return &manifest.Manifest{}, nil
+	}
+	if err != nil {
+		w.logger().Error("failed to read manifest", slog.String(log.KeyFile, path), log.Err(err))
+		return nil, err
+	}
+
+	m, err := manifest.Read(bytes.NewReader(data))
+	if err != nil {
+		w.logger().Error("failed to decode manifest", slog.String(log.KeyFile, path), log.Err(err))
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (w *Walker) writeManifest(path string, m *manifest.Manifest) error {
+	data, err := m.Encode()
+	if err != nil {
+		w.logger().Error("failed to encode manifest", log.Err(err))
+		return err
+	}
+
+	return w.Writer.Write(path, data)
+}
+
+func (w *Walker) walkPackage(pkg *loader.PackageInfo, prgm *loader.Program, directions Directions, clients []clientFilter, out output) ([]manifest.File, error) {
+	service := directions.Service
+	logger := w.logger().With(
+		slog.String(log.KeyPackage, pkg.Pkg.Path()),
+		slog.String(log.KeyDeclaration, directions.Name))
+
+	svc, visitor := w.describePackage(pkg, prgm, directions, nil)
+	if svc == nil {
+		logger.Error("could not find RPC declaration")
+		w.reporter().Report(diagnostic.Diagnostic{
+			Severity: diagnostic.SeverityError,
+			Rule:     "declaration-not-found",
+			Message:  fmt.Sprintf("could not find RPC declaration %s with suitable methods in %s", directions.Name, pkg.Pkg.Path()),
+			File:     localDir(directions.Path),
+		})
+		return nil, errors.New("not found")
+	}
+	if err := visitor.Err(); err != nil {
+		logger.Error("invalid annotations", log.Err(err))
+		return nil, err
+	}
+
+	// Render every filename before writing any file, so clients don't
+	// overwrite each other.
+	fnames := make([]string, len(clients))
+	written := map[string]string{}
+	for i, c := range clients {
+		fname, err := out.filenameFor(service, c.name, w.Provider.Name())
+		if err != nil {
+			logger.Error("failed to render filename", slog.String(log.KeyClient, c.name), log.Err(err))
+			return nil, err
+		}
+		if other, ok := written[fname]; ok {
+			err := fmt.Errorf("clients %s and %s are both written to %s, use a filename template that references .Client", other, c.name, fname)
+			logger.Error("duplicate filename", slog.String(log.KeyClient, c.name), log.Err(err))
+			return nil, err
+		}
+		written[fname] = c.name
+		fnames[i] = fname
+	}
+
+	var files []manifest.File
+	for i, c := range clients {
+		var selected []spec.Method
+		for _, m := range svc.Methods {
+			if c.filter.Match(m.Name) {
+				selected = append(selected, m)
+			} else {
+				logger.Debug("skipping method",
+					slog.String(log.KeyMethod, m.Name),
+					slog.String(log.KeyClient, c.name),
+					slog.String(log.KeyReason, "filtered out"))
+			}
+		}
+
+		if len(selected) == 0 {
+			logger.Error("no methods left after filtering", slog.String(log.KeyClient, c.name))
+			w.reporter().Report(diagnostic.At(prgm.Fset.Position(visitor.Declaration().Obj().Pos()),
+				diagnostic.SeverityError, "no-methods",
+				fmt.Sprintf("no methods of %s left for client %s after filtering", directions.Name, c.name)))
+			return nil, errors.New("no methods")
+		}
+
+		if err := w.checkClientNames(selected, c.name); err != nil {
+			logger.Error("client method names collide", slog.String(log.KeyClient, c.name), log.Err(err))
+			return nil, err
+		}
+
+		fname := fnames[i]
+
+		var lock *generator.Lockfile
+		if out.format.Lockfile {
+			var err error
+			lock, err = w.readLockfile(fname + LockfileSuffix)
+			if err != nil {
+				logger.Error("failed to read lockfile", slog.String(log.KeyFile, fname+LockfileSuffix), log.Err(err))
+				return nil, err
+			}
+		}
+
+		src, err := out.format.Generate(generator.GenerateInput{
+			PackageName: out.pkg,
+			Service:     svc,
+			Methods:     selected,
+			Identifier:  c.name,
+			Header: generator.Header{
+				Version: directions.version(),
+				Command: directions.Command,
+				Source:  pkg.Pkg.Path(),
+			},
+			Logger:    logger,
+			Lockfile:  lock,
+			Mocks:     directions.Mocks,
+			InProcess: directions.InProcess,
+		})
+		if err != nil {
+			logger.Error("failed to generate client", slog.String(log.KeyClient, c.name), log.Err(err))
+			w.reporter().Report(diagnostic.Diagnostic{
+				Severity: diagnostic.SeverityError,
+				Rule:     "generate",
+				// fname is relative to the output, not to the source.
+				Message: fname + ": " + err.Error(),
+			})
+			return nil, err
 		}
 
-		fname := fmt.Sprintf("generated_%s.go", ident)
 		if err := w.Writer.Write(fname, src); err != nil {
-			return err
+			return nil, err
+		}
+		if lock != nil {
+			if err := w.Writer.Write(fname+LockfileSuffix, lock.Data); err != nil {
+				return nil, err
+			}
+		}
+
+		f := manifest.File{
+			Path:        fname,
+			Package:     out.pkg,
+			Source:      pkg.Pkg.Path(),
+			Declaration: directions.Name,
+			Service:     service,
+			Client:      c.name,
+			Provider:    w.Provider.Name(),
+			Methods:     manifestMethods(selected),
+			Hash:        manifest.Hash(src),
+		}
+		if lock != nil {
+			f.Lockfile = fname + LockfileSuffix
+		}
+		files = append(files, f)
+
+		logger.Info("generated client",
+			slog.String(log.KeyClient, c.name),
+			slog.String(log.KeyFile, fname))
+	}
+
+	return files, nil
+}
+
+// describePackage visits pkg and describes the service declared in it with
+// the methods matching f, or returns nil if it has no RPC declaration with
+// such methods.
+func (w *Walker) describePackage(pkg *loader.PackageInfo, prgm *loader.Program, directions Directions, f *filter.Filter) (*spec.Service, *Visitor) {
+	visitor := NewVisitor(VisitorConfig{
+		Pkg:         pkg,
+		Provider:    w.Provider,
+		Declaration: directions.Name,
+		Logger:      w.Logger,
+		Reporter:    w.Reporter,
+		Fset:        prgm.Fset,
+	})
+
+	var funcs []*types.Func
+	for _, fn := range visitor.Go()[directions.Name] {
+		if f.Match(fn.Name()) {
+			funcs = append(funcs, fn)
 		}
+	}
+	if len(funcs) == 0 {
+		return nil, visitor
+	}
 
-		log.Printf("glue: generated %s", ident)
+	return spec.Build(spec.Input{
+		Program:     prgm,
+		Provider:    w.Provider,
+		Service:     directions.Service,
+		Declaration: visitor.Declaration(),
+		Methods:     funcs,
+		Annotations: visitor.Annotations(),
+		Root:        directions.root(),
+	}), visitor
+}
+
+// readLockfile reads the lockfile at path from the output, if the Writer can
+// read it back.
+func (w *Walker) readLockfile(path string) (*generator.Lockfile, error) {
+	lock := &generator.Lockfile{}
+	r, ok := w.Writer.(writer.Reader)
+	if !ok {
+		return lock, nil
 	}
 
+	data, err := r.Read(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	lock.Data = data
+
+	return lock, nil
+}
+
+// checkClientNames reports methods of client whose names in generated clients,
+// or the names of their `…Context` variants, are the same.
+func (w *Walker) checkClientNames(methods []spec.Method, client string) error {
+	names := make(map[string]spec.Method, 2*len(methods))
+	collided := map[string]bool{}
+	var collisions []string
+	for _, m := range methods {
+		for _, name := range []string{m.ClientName(), m.ClientName() + "Context"} {
+			prev, ok := names[name]
+			if !ok {
+				names[name] = m
+				continue
+			}
+
+			if !collided[name] {
+				collided[name] = true
+				collisions = append(collisions, name)
+			}
+			w.reporter().Report(diagnostic.Diagnostic{
+				Severity: diagnostic.SeverityError,
+				Rule:     "name-collision",
+				Message:  fmt.Sprintf("%s and %s both generate method %s of client %s", prev.Name, m.Name, name, client),
+				File:     m.File,
+				Line:     m.Line,
+			})
+		}
+	}
+
+	if len(collisions) > 0 {
+		return fmt.Errorf("client %s has colliding methods: %s", client, strings.Join(collisions, ", "))
+	}
 	return nil
 }
+
+func manifestMethods(methods []spec.Method) []manifest.Method {
+	ret := make([]manifest.Method, 0, len(methods))
+	for _, m := range methods {
+		ret = append(ret, manifest.Method{
+			Name: m.ClientName(),
+			RPC:  m.RPC,
+		})
+	}
+
+	return ret
+}
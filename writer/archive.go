@@ -0,0 +1,109 @@
+package writer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ZipWriter streams generated files into a zip archive. Close must be called
+// to finish the archive; it doesn't close the underlying io.Writer.
+type ZipWriter struct {
+	mu      sync.Mutex
+	zw      *zip.Writer
+	baseDir string
+	modTime time.Time
+}
+
+// NewZipWriter creates a ZipWriter that writes an archive to w. Files are
+// stored under dir within the archive.
+func NewZipWriter(w io.Writer, dir string) *ZipWriter {
+	return &ZipWriter{
+		zw:      zip.NewWriter(w),
+		baseDir: dir,
+		modTime: time.Now(),
+	}
+}
+
+func (z *ZipWriter) Write(p string, data []byte) error {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	header := &zip.FileHeader{
+		Name:   archivePath(z.baseDir, p),
+		Method: zip.Deflate,
+	}
+	header.SetModTime(z.modTime)
+	header.SetMode(0644)
+
+	f, err := z.zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	return err
+}
+
+// Close finishes the archive.
+func (z *ZipWriter) Close() error {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	return z.zw.Close()
+}
+
+// TarWriter streams generated files into a tar archive. Close must be called
+// to finish the archive; it doesn't close the underlying io.Writer.
+type TarWriter struct {
+	mu      sync.Mutex
+	tw      *tar.Writer
+	baseDir string
+	modTime time.Time
+}
+
+// NewTarWriter creates a TarWriter that writes an archive to w. Files are
+// stored under dir within the archive.
+func NewTarWriter(w io.Writer, dir string) *TarWriter {
+	return &TarWriter{
+		tw:      tar.NewWriter(w),
+		baseDir: dir,
+		modTime: time.Now(),
+	}
+}
+
+func (t *TarWriter) Write(p string, data []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	err := t.tw.WriteHeader(&tar.Header{
+		Name:     archivePath(t.baseDir, p),
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  t.modTime,
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = t.tw.Write(data)
+	return err
+}
+
+// Close finishes the archive.
+func (t *TarWriter) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.tw.Close()
+}
+
+// archivePath returns the slash-separated path of a file within an archive.
+func archivePath(dir, p string) string {
+	return path.Clean(path.Join(filepath.ToSlash(dir), filepath.ToSlash(p)))
+}
@@ -0,0 +1,102 @@
+package writer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/segmentio/glue/internal/diff"
+)
+
+// CheckWriter compares generated code against the files already on disk
+// instead of writing it. It's used to verify generated code is up to date.
+type CheckWriter struct {
+	baseDir string
+
+	mu    sync.Mutex
+	stale map[string]string
+}
+
+// NewCheckWriter creates a CheckWriter that compares against files in dir.
+func NewCheckWriter(dir string) *CheckWriter {
+	return &CheckWriter{
+		baseDir: dir,
+		stale:   map[string]string{},
+	}
+}
+
+// Write records a diff if the file at path is missing or differs from data.
+func (cw *CheckWriter) Write(path string, data []byte) error {
+	fullPath := filepath.Join(cw.baseDir, path)
+
+	existing, err := ioutil.ReadFile(fullPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+
+	to := filepath.ToSlash(fullPath)
+	from := to
+	if os.IsNotExist(err) {
+		from = "/dev/null"
+	}
+
+	cw.mu.Lock()
+	cw.stale[path] = diff.Unified(from, to, existing, data)
+	cw.mu.Unlock()
+
+	return nil
+}
+
+// Read reads a file from the directory generated code is compared against.
+func (cw *CheckWriter) Read(path string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(cw.baseDir, path))
+}
+
+// Remove records a diff if the file at path exists, since FileWriter would
+// remove it.
+func (cw *CheckWriter) Remove(path string) error {
+	fullPath := filepath.Join(cw.baseDir, path)
+	existing, err := ioutil.ReadFile(fullPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cw.mu.Lock()
+	cw.stale[path] = diff.Unified(filepath.ToSlash(fullPath), "/dev/null", existing, nil)
+	cw.mu.Unlock()
+
+	return nil
+}
+
+// Stale returns the paths of files that are missing or out of date, sorted.
+func (cw *CheckWriter) Stale() []string {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+
+	paths := make([]string, 0, len(cw.stale))
+	for path := range cw.stale {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// Diff returns a unified diff from the file on disk to the generated code for
+// a stale path. It's empty if the path is up to date.
+func (cw *CheckWriter) Diff(path string) string {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+
+	return cw.stale[path]
+}
@@ -0,0 +1,97 @@
+package writer_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/segmentio/glue/writer"
+)
+
+func TestCheckWriter(t *testing.T) {
+	dir := t.TempDir()
+	for path, data := range map[string]string{
+		"same.go":      "package client\n",
+		"changed.go":   "package client\n\nvar A = 1\n",
+		"removed.go":   "package client\n",
+		"sub/deep.go":  "package client\n",
+		"untouched.go": "package client\n",
+	} {
+		full := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(full, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cw := writer.NewCheckWriter(dir)
+	for path, data := range map[string]string{
+		"same.go":     "package client\n",
+		"changed.go":  "package client\n\nvar A = 2\n",
+		"created.go":  "package client\n",
+		"sub/deep.go": "package client\n",
+	} {
+		if err := cw.Write(path, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{"removed.go", "missing.go"} {
+		if err := cw.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{"changed.go", "created.go", "removed.go"}
+	if got := cw.Stale(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Stale() = %q, want %q", got, want)
+	}
+
+	base := filepath.ToSlash(dir)
+	diffs := []struct {
+		path string
+		want string
+	}{
+		{"same.go", ""},
+		{"sub/deep.go", ""},
+		{"untouched.go", ""},
+		{
+			"changed.go",
+			"--- " + base + "/changed.go\n+++ " + base + "/changed.go\n" +
+				"@@ -1,3 +1,3 @@\n package client\n \n-var A = 1\n+var A = 2\n",
+		},
+		{
+			"created.go",
+			"--- /dev/null\n+++ " + base + "/created.go\n@@ -0,0 +1,1 @@\n+package client\n",
+		},
+		{
+			"removed.go",
+			"--- " + base + "/removed.go\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-package client\n",
+		},
+	}
+	for _, d := range diffs {
+		if got := cw.Diff(d.path); got != d.want {
+			t.Errorf("Diff(%q) =\n%s\nwant\n%s", d.path, got, d.want)
+		}
+	}
+
+	// Nothing is written to or removed from the directory.
+	for path, want := range map[string]string{
+		"changed.go": "package client\n\nvar A = 1\n",
+		"removed.go": "package client\n",
+	} {
+		data, err := cw.Read(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Errorf("%s = %q, want %q", path, data, want)
+		}
+	}
+	if _, err := cw.Read("created.go"); !os.IsNotExist(err) {
+		t.Errorf("created.go: err = %v, want it not to exist", err)
+	}
+}
@@ -1,20 +1,28 @@
 package writer
 
 import (
+	"bytes"
+	"io/ioutil"
+	"log/slog"
 	"os"
 	"path/filepath"
 
 	"github.com/segmentio/glue/log"
 )
 
+// FileWriter writes files to a directory. Files are replaced atomically and
+// left untouched if their content is unchanged.
 type FileWriter struct {
+	// Logger defaults to log.Default.
+	Logger *slog.Logger
+
 	baseDir string
 }
 
 func NewFileWriter(dir string) (*FileWriter, error) {
 	if dir != "" {
 		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-			log.Printf("failed to create output directory: %s", err.Error())
+			log.Default().Error("failed to create output directory", log.Err(err))
 			return nil, err
 		}
 	}
@@ -23,17 +31,75 @@ func NewFileWriter(dir string) (*FileWriter, error) {
 }
 
 func (fw *FileWriter) Write(path string, data []byte) error {
-	f, err := os.Create(filepath.Join(fw.baseDir, path))
-	if err != nil {
-		log.Printf("failed to create file: %s", err.Error())
+	fullPath := filepath.Join(fw.baseDir, path)
+	if existing, err := ioutil.ReadFile(fullPath); err == nil && bytes.Equal(existing, data) {
+		log.OrDefault(fw.Logger).Debug("skipping file",
+			slog.String(log.KeyFile, path),
+			slog.String(log.KeyReason, "unchanged"))
+		return nil
+	}
+
+	if err := writeAtomic(fullPath, data); err != nil {
+		log.OrDefault(fw.Logger).Error("failed to write file", slog.String(log.KeyFile, path), log.Err(err))
 		return err
 	}
 
-	_, err = f.Write(data)
+	return nil
+}
+
+// Read reads a file from the output directory.
+func (fw *FileWriter) Read(path string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(fw.baseDir, path))
+}
+
+// Remove removes a file from the output directory.
+func (fw *FileWriter) Remove(path string) error {
+	logger := log.OrDefault(fw.Logger)
+
+	err := os.Remove(filepath.Join(fw.baseDir, path))
+	if os.IsNotExist(err) {
+		return nil
+	}
 	if err != nil {
-		log.Printf("failed to write to file: %s", err.Error())
+		logger.Error("failed to remove stale file", slog.String(log.KeyFile, path), log.Err(err))
 		return err
 	}
 
+	logger.Info("removed stale file", slog.String(log.KeyFile, path))
 	return nil
 }
+
+// writeAtomic writes data to a temporary file next to path and renames it into
+// place, so readers never observe a partially written file.
+func writeAtomic(path string, data []byte) error {
+	dir, base := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	// Filename templates may write to subdirectories (e.g. `sub/{{ .Client }}.go`).
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+base+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
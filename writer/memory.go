@@ -0,0 +1,59 @@
+package writer
+
+import (
+	"os"
+	"sync"
+)
+
+// MemoryWriter keeps generated files in memory. It's useful when embedding
+// glue in other tools.
+type MemoryWriter struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func NewMemoryWriter() *MemoryWriter {
+	return &MemoryWriter{files: map[string][]byte{}}
+}
+
+func (mw *MemoryWriter) Write(path string, data []byte) error {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	mw.files[path] = append([]byte(nil), data...)
+	return nil
+}
+
+// Read returns a copy of a written file.
+func (mw *MemoryWriter) Read(path string) ([]byte, error) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	data, ok := mw.files[path]
+	if !ok {
+		return nil, &os.PathError{Op: "read", Path: path, Err: os.ErrNotExist}
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// Remove removes a written file.
+func (mw *MemoryWriter) Remove(path string) error {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	delete(mw.files, path)
+	return nil
+}
+
+// Files returns a copy of the written files keyed by path.
+func (mw *MemoryWriter) Files() map[string][]byte {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	files := make(map[string][]byte, len(mw.files))
+	for path, data := range mw.files {
+		files[path] = append([]byte(nil), data...)
+	}
+
+	return files
+}
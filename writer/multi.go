@@ -0,0 +1,47 @@
+package writer
+
+import "os"
+
+// MultiWriter writes every file to each of its writers, in order. It stops at
+// the first error.
+type MultiWriter struct {
+	writers []Writer
+}
+
+func NewMultiWriter(writers ...Writer) *MultiWriter {
+	return &MultiWriter{writers: writers}
+}
+
+func (m *MultiWriter) Write(path string, data []byte) error {
+	for _, w := range m.writers {
+		if err := w.Write(path, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remove removes path from each writer that's a Remover.
+func (m *MultiWriter) Remove(path string) error {
+	for _, w := range m.writers {
+		if r, ok := w.(Remover); ok {
+			if err := r.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Read reads a file from the first writer that's a Reader.
+func (m *MultiWriter) Read(path string) ([]byte, error) {
+	for _, w := range m.writers {
+		if r, ok := w.(Reader); ok {
+			return r.Read(path)
+		}
+	}
+
+	return nil, &os.PathError{Op: "read", Path: path, Err: os.ErrNotExist}
+}
@@ -1,16 +1,42 @@
 package writer
 
 import (
-	"github.com/segmentio/glue/log"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync"
 )
 
-type StdoutWriter struct{}
+// StdoutWriter writes generated files to a stream in txtar format: each file is
+// preceded by a `-- path --` separator line, so the output of several files
+// can be told apart and split back into files.
+type StdoutWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
 
+// NewStdoutWriter creates a StdoutWriter that writes to os.Stdout.
 func NewStdoutWriter() *StdoutWriter {
-	return &StdoutWriter{}
+	return NewStdoutWriterTo(os.Stdout)
+}
+
+// NewStdoutWriterTo creates a StdoutWriter that writes to w.
+func NewStdoutWriterTo(w io.Writer) *StdoutWriter {
+	return &StdoutWriter{out: w}
 }
 
-func (s *StdoutWriter) Write(_path string, data []byte) error {
-	log.Print(string(data))
-	return nil
+func (s *StdoutWriter) Write(path string, data []byte) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "-- %s --\n", path)
+	b.Write(data)
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		b.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := s.out.Write(b.Bytes())
+	return err
 }
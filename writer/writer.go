@@ -3,3 +3,19 @@ package writer
 type Writer interface {
 	Write(path string, data []byte) error
 }
+
+// A Remover is a Writer that can remove files from its output, e.g. files
+// generated in earlier runs that aren't generated anymore. Removing a file
+// that doesn't exist isn't an error.
+type Remover interface {
+	Writer
+	Remove(path string) error
+}
+
+// A Reader is a Writer that can read back the files of its output, e.g. the
+// lockfile of an earlier run. Read returns an error satisfying os.IsNotExist
+// if the file doesn't exist.
+type Reader interface {
+	Writer
+	Read(path string) ([]byte, error)
+}